@@ -15,6 +15,7 @@ type ArticleService interface {
 	GetCountByBlogID(context.Context, int64, interface{}) (int, error)
 	Create(context.Context, int64, *Article) (*Article, error)
 	Update(context.Context, int64, int64, *Article) (*Article, error)
+	Patch(context.Context, int64, int64, *Patch[Article]) (*Article, error)
 	Delete(context.Context, int64, int64) error
 }
 
@@ -108,6 +109,19 @@ func (s *ArticleServiceOp) Update(ctx context.Context, blogID int64, articleID i
 	return resource.Article, err
 }
 
+// Patch updates only the fields set in the patch of an existing article
+func (s *ArticleServiceOp) Patch(ctx context.Context, blogID int64, articleID int64, patch *Patch[Article]) (*Article, error) {
+	body, err := patch.body(articleID)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("blogs/%v/articles/%v.json", blogID, articleID)
+	wrappedData := map[string]interface{}{"article": body}
+	resource := new(ArticleResource)
+	err = s.client.Put(ctx, path, wrappedData, resource)
+	return resource.Article, err
+}
+
 // Delete an existing product
 func (s *ArticleServiceOp) Delete(ctx context.Context, blogID int64, articleID int64) error {
 	return s.client.Delete(ctx, fmt.Sprintf("blogs/%v/articles/%v.json", blogID, articleID))
@@ -0,0 +1,154 @@
+package goshopify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// maxRedirects is the number of 303 responses followed for a request
+	maxRedirects = 10
+
+	// defaultPollInterval is the wait between polls of a 202 Location when
+	// Shopify sends no Retry-After
+	defaultPollInterval = time.Second
+)
+
+// PendingError is returned for a 202 Accepted response without a body by a
+// client made with WithPendingErrors, the work Shopify accepted is not
+// completed yet. The result can be retrieved with Client.WaitForCompletion.
+type PendingError struct {
+	// Location is the url to poll for the result
+	Location string
+
+	// RetryAfter is how long to wait before polling
+	RetryAfter time.Duration
+
+	// decoded is set when the body of the response was decoded into the
+	// resource of the call
+	decoded bool
+}
+
+func (e PendingError) Error() string {
+	return fmt.Sprintf("shopify: request accepted, result pending at %s", e.Location)
+}
+
+// WaitForCompletion polls the Location of a 202 response, honoring
+// Retry-After, until the resource is ready and decodes it into resource. It
+// returns when ctx is done. The access token is not sent to a Location on
+// another host than the shop.
+//
+//	var pending goshopify.PendingError
+//	if errors.As(err, &pending) {
+//		err = client.WaitForCompletion(ctx, pending.Location, &resource)
+//	}
+func (c *Client) WaitForCompletion(ctx context.Context, location string, resource interface{}) error {
+	_, err := c.poll(ctx, PendingError{Location: location}, resource)
+	return err
+}
+
+// poll gets the Location of a pending response until it is not accepted
+// anymore
+func (c *Client) poll(ctx context.Context, pending PendingError, v interface{}) (http.Header, error) {
+	for {
+		timer := time.NewTimer(pending.RetryAfter)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		req, err := c.NewRequest(ctx, "GET", pending.Location, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		// the Location is given by the response, the token is only sent to
+		// the shop
+		dropCredentials(req, c.baseURL.Host)
+		headers, err := c.doRequest(req, v)
+		next, ok := err.(PendingError)
+		if !ok {
+			return headers, err
+		}
+		c.log.Debugf("result pending at %s, waiting %s", next.Location, next.RetryAfter)
+		pending = next
+	}
+}
+
+// accepted decodes the body of a 202 response if any and returns the
+// PendingError of its Location
+func (c *Client) accepted(req *http.Request, resp *http.Response, v interface{}) (http.Header, error) {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	location, err := req.URL.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		return nil, fmt.Errorf("parse location: %w", err)
+	}
+
+	pending := PendingError{
+		Location:   location.String(),
+		RetryAfter: defaultPollInterval,
+	}
+	if f, err := strconv.ParseFloat(resp.Header.Get("Retry-After"), 64); err == nil && f >= 0 {
+		pending.RetryAfter = time.Duration(f * float64(time.Second))
+	}
+
+	if v != nil && len(bytes.TrimSpace(body)) > 0 {
+		if err := json.Unmarshal(body, &v); err != nil {
+			return nil, fmt.Errorf("decode response: %w", err)
+		}
+		pending.decoded = true
+	}
+	return resp.Header, pending
+}
+
+// seeOtherRequest returns the GET request of the Location of a 303 response.
+// The credentials are only sent to the same host.
+func seeOtherRequest(req *http.Request, resp *http.Response) (*http.Request, error) {
+	location, err := req.URL.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		return nil, fmt.Errorf("parse location: %w", err)
+	}
+	next, err := http.NewRequestWithContext(req.Context(), "GET", location.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	next.Header = req.Header.Clone()
+	next.Header.Del("Content-Type")
+	next.Header.Del("Content-Length")
+	dropCredentials(next, req.URL.Host)
+	return next, nil
+}
+
+// checkRedirect is the redirect policy of the http clients. The 303
+// responses are returned to be followed by the client, and the credentials
+// are dropped from the other redirects to another host, as http.Client only
+// drops its own sensitive headers and would forward X-Shopify-Access-Token.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if req.Response != nil && req.Response.StatusCode == http.StatusSeeOther {
+		return http.ErrUseLastResponse
+	}
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+	dropCredentials(req, via[0].URL.Host)
+	return nil
+}
+
+// dropCredentials removes the credentials of a request sent to another host
+func dropCredentials(req *http.Request, host string) {
+	if req.URL.Host != host {
+		req.Header.Del("X-Shopify-Access-Token")
+		req.Header.Del("Authorization")
+	}
+}
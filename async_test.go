@@ -0,0 +1,246 @@
+package goshopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestDoSeeOther(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/products.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusSeeOther, "")
+			resp.Header.Set("Location", "products/1.json")
+			return resp, nil
+		})
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products/1.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("X-Shopify-Access-Token") != "abcd" {
+				return httpmock.NewStringResponse(401, `{"errors":"[API] Invalid API key or access token"}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"product":{"id":1}}`), nil
+		})
+
+	var attempts []string
+	client.middlewares = []Middleware{func(next RequestHandler) RequestHandler {
+		return func(req *http.Request, info RequestInfo) (*http.Response, error) {
+			attempts = append(attempts, fmt.Sprintf("%s %d", info.Method, info.Attempt))
+			return next(req, info)
+		}
+	}}
+
+	product, err := client.Product.Create(context.Background(), Product{Title: "foo"})
+	if err != nil {
+		t.Fatalf("Product.Create returned error: %v", err)
+	}
+	if product.ID != 1 {
+		t.Errorf("Product.Create returned %+v, expected the product of the Location", product)
+	}
+	// the GET of the Location is the first attempt of its request
+	if expected := []string{"POST 1", "GET 1"}; !reflect.DeepEqual(attempts, expected) {
+		t.Errorf("middleware saw attempts %v, expected %v", attempts, expected)
+	}
+}
+
+func TestDoSeeOtherLoop(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products/1.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusSeeOther, "")
+			resp.Header.Set("Location", "1.json")
+			return resp, nil
+		})
+
+	_, err := client.Product.Get(context.Background(), 1, nil)
+	expected := fmt.Sprintf("stopped after %d redirects", maxRedirects)
+	if err == nil || err.Error() != expected {
+		t.Errorf("Product.Get returned %v, expected %q", err, expected)
+	}
+}
+
+func TestDoRedirectOtherHost(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// the default http client of NewClient
+	client = NewClient(app, "fooshop", "abcd", WithVersion(testApiVersion))
+	httpmock.ActivateNonDefault(client.Client)
+
+	var tokens []string
+	httpmock.RegisterResponder("GET", "https://other.example.com/products/1.json",
+		func(req *http.Request) (*http.Response, error) {
+			tokens = append(tokens, req.Header.Get("X-Shopify-Access-Token"))
+			return httpmock.NewStringResponse(200, `{"product":{"id":1}}`), nil
+		})
+	for _, status := range []int{http.StatusSeeOther, http.StatusFound} {
+		httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/products.json", client.pathPrefix),
+			func(req *http.Request) (*http.Response, error) {
+				resp := httpmock.NewStringResponse(status, "")
+				resp.Header.Set("Location", "https://other.example.com/products/1.json")
+				return resp, nil
+			})
+
+		product, err := client.Product.Create(context.Background(), Product{Title: "foo"})
+		if err != nil || product.ID != 1 {
+			t.Errorf("Product.Create after a %d returned %+v, %v, expected the product of the Location", status, product, err)
+		}
+	}
+
+	if len(tokens) != 2 || tokens[0] != "" || tokens[1] != "" {
+		t.Errorf("access tokens sent to another host = %q, expected none", tokens)
+	}
+}
+
+func TestWithHTTPClientRedirectPolicy(t *testing.T) {
+	custom := &http.Client{Timeout: time.Second}
+	c := NewClient(app, "fooshop", "abcd", WithHTTPClient(custom))
+	if c.Client.CheckRedirect == nil || custom.CheckRedirect != nil || c.Client.Timeout != time.Second {
+		t.Errorf("WithHTTPClient client.Client = %+v, expected a copy with the redirect policy", c.Client)
+	}
+
+	policy := func(req *http.Request, via []*http.Request) error { return nil }
+	custom = &http.Client{CheckRedirect: policy}
+	if c := NewClient(app, "fooshop", "abcd", WithHTTPClient(custom)); c.Client != custom {
+		t.Errorf("WithHTTPClient replaced a client with its own redirect policy")
+	}
+}
+
+// registerAccepted registers a theme creation accepted with a 202 response
+// and a Location which is ready after the given number of polls
+func registerAccepted(polls int) *int {
+	count := 0
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/themes.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Set("Location", "/admin/jobs/1.json")
+			resp.Header.Set("Retry-After", "0.01")
+			return resp, nil
+		})
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/jobs/1.json",
+		func(req *http.Request) (*http.Response, error) {
+			count++
+			if count < polls {
+				resp := httpmock.NewStringResponse(http.StatusAccepted, `{"theme":{"id":1,"processing":true}}`)
+				resp.Header.Set("Location", "/admin/jobs/1.json")
+				resp.Header.Set("Retry-After", "0.01")
+				return resp, nil
+			}
+			return httpmock.NewStringResponse(200, `{"theme":{"id":1,"name":"Dawn"}}`), nil
+		})
+	return &count
+}
+
+func TestDoAcceptedPending(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client = NewClient(app, "fooshop", "abcd", WithVersion(testApiVersion), WithPendingErrors())
+	httpmock.ActivateNonDefault(client.Client)
+	count := registerAccepted(3)
+
+	_, err := client.Theme.Create(context.Background(), Theme{Name: "Dawn"})
+	var pending PendingError
+	if !errors.As(err, &pending) {
+		t.Fatalf("Theme.Create returned %v, expected a PendingError", err)
+	}
+	if pending.Location != "https://fooshop.myshopify.com/admin/jobs/1.json" || pending.RetryAfter != 10*time.Millisecond {
+		t.Errorf("Theme.Create returned %+v, expected the Location and Retry-After of the response", pending)
+	}
+
+	resource := new(ThemeResource)
+	if err := client.WaitForCompletion(context.Background(), pending.Location, resource); err != nil {
+		t.Fatalf("Client.WaitForCompletion returned error: %v", err)
+	}
+	if resource.Theme.Name != "Dawn" || *count != 3 {
+		t.Errorf("Client.WaitForCompletion returned %+v after %d polls, expected the theme after 3 polls", resource.Theme, *count)
+	}
+}
+
+func TestDoAcceptedWaitForCompletion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client = NewClient(app, "fooshop", "abcd", WithVersion(testApiVersion), WithWaitForCompletion())
+	httpmock.ActivateNonDefault(client.Client)
+	count := registerAccepted(3)
+
+	theme, err := client.Theme.Create(context.Background(), Theme{Name: "Dawn"})
+	if err != nil {
+		t.Fatalf("Theme.Create returned error: %v", err)
+	}
+	if theme.ID != 1 || theme.Name != "Dawn" || *count != 3 {
+		t.Errorf("Theme.Create returned %+v after %d polls, expected the theme after 3 polls", theme, *count)
+	}
+}
+
+func TestDoAcceptedWaitForCompletionCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client = NewClient(app, "fooshop", "abcd", WithVersion(testApiVersion), WithWaitForCompletion())
+	httpmock.ActivateNonDefault(client.Client)
+	registerAccepted(1000)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.Theme.Create(ctx, Theme{Name: "Dawn"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Theme.Create returned %v, expected the context error", err)
+	}
+}
+
+func TestDoAcceptedOtherHost(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client = NewClient(app, "fooshop", "abcd", WithVersion(testApiVersion), WithWaitForCompletion())
+	httpmock.ActivateNonDefault(client.Client)
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/themes.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Set("Location", "https://other.example.com/jobs/1.json")
+			resp.Header.Set("Retry-After", "0")
+			return resp, nil
+		})
+	var token string
+	httpmock.RegisterResponder("GET", "https://other.example.com/jobs/1.json",
+		func(req *http.Request) (*http.Response, error) {
+			token = req.Header.Get("X-Shopify-Access-Token") + req.Header.Get("Authorization")
+			return httpmock.NewStringResponse(200, `{"theme":{"id":1}}`), nil
+		})
+
+	if _, err := client.Theme.Create(context.Background(), Theme{Name: "Dawn"}); err != nil {
+		t.Fatalf("Theme.Create returned error: %v", err)
+	}
+	if token != "" {
+		t.Errorf("credentials %q sent to the Location on another host, expected none", token)
+	}
+}
+
+func TestDoAcceptedSucceeds(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// without WithPendingErrors nor WithWaitForCompletion a 202 succeeds
+	count := registerAccepted(3)
+	theme, err := client.Theme.Create(context.Background(), Theme{Name: "Dawn"})
+	if err != nil {
+		t.Fatalf("Theme.Create returned error: %v", err)
+	}
+	if theme != nil || *count != 0 {
+		t.Errorf("Theme.Create returned %+v after %d polls, expected no theme without polling", theme, *count)
+	}
+}
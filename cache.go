@@ -0,0 +1,307 @@
+package goshopify
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultCacheCapacity = 1000
+
+// DefaultCacheTTLs are the TTLs used by NewCache for read-heavy resources
+// which rarely change, keyed by the top level resource of the request path
+var DefaultCacheTTLs = map[string]time.Duration{
+	"shop":           5 * time.Minute,
+	"themes":         time.Minute,
+	"locations":      5 * time.Minute,
+	"shipping_zones": 5 * time.Minute,
+	"policies":       5 * time.Minute,
+	"currencies":     5 * time.Minute,
+}
+
+// CacheEntry is a cached GET response
+type CacheEntry struct {
+	Status    int
+	Header    http.Header
+	Body      []byte
+	ExpiresAt time.Time
+}
+
+// CacheStore stores cached responses. Implementations must be safe for
+// concurrent use.
+type CacheStore interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+	// DeletePrefix removes all entries whose key starts with prefix
+	DeletePrefix(prefix string)
+}
+
+// Cache caches GET responses of a client. Entries are keyed by shop, api
+// version, path, query and a hash of the access token, so clients with other
+// tokens and scopes don't share them. Stale entries with an ETag are
+// revalidated with If-None-Match. A successful POST, PUT or DELETE removes all entries of the
+// resource it was sent to, e.g. updating an asset invalidates all cached
+// "themes" responses of the shop.
+// A Cache can be shared by several clients, see WithCache.
+type Cache struct {
+	Store CacheStore
+
+	// TTLs are keyed by the top level resource of the request path, e.g.
+	// "shop" or "themes". Resources without a TTL use DefaultTTL.
+	TTLs map[string]time.Duration
+
+	// DefaultTTL is the TTL of resources without an entry in TTLs, defaults
+	// to 0 which doesn't cache them
+	DefaultTTL time.Duration
+
+	// Internal testing use only.
+	now func() time.Time
+}
+
+// NewCache returns a Cache with DefaultCacheTTLs. If store is nil an
+// in-memory LRU store of 1000 entries is used.
+func NewCache(store CacheStore) *Cache {
+	if store == nil {
+		store = NewMemoryCacheStore(defaultCacheCapacity)
+	}
+
+	ttls := make(map[string]time.Duration, len(DefaultCacheTTLs))
+	for resource, ttl := range DefaultCacheTTLs {
+		ttls[resource] = ttl
+	}
+
+	return &Cache{
+		Store: store,
+		TTLs:  ttls,
+	}
+}
+
+// Middleware returns the middleware serving and storing cached responses
+func (c *Cache) Middleware() Middleware {
+	return func(next RequestHandler) RequestHandler {
+		return func(req *http.Request, info RequestInfo) (*http.Response, error) {
+			if req.Method != http.MethodGet {
+				resp, err := next(req, info)
+				if err == nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+					c.Store.DeletePrefix(cacheResourcePrefix(info))
+				}
+				return resp, err
+			}
+
+			ttl := c.ttl(info)
+			if ttl <= 0 {
+				return next(req, info)
+			}
+
+			key := cacheKey(info, req)
+			entry, ok := c.Store.Get(key)
+			if ok && c.clock().Before(entry.ExpiresAt) {
+				return entry.response(req), nil
+			}
+
+			etag := ""
+			if ok {
+				etag = entry.Header.Get("ETag")
+			}
+			if etag != "" {
+				req.Header.Set("If-None-Match", etag)
+			}
+
+			resp, err := next(req, info)
+			if err != nil {
+				return resp, err
+			}
+
+			if resp.StatusCode == http.StatusNotModified && etag != "" {
+				resp.Body.Close()
+				refreshed := *entry
+				refreshed.Header = entry.Header.Clone()
+				for key, values := range resp.Header {
+					// the 304 updates the headers, e.g. the api call limit,
+					// but not the description of the cached body
+					if !strings.HasPrefix(key, "Content-") {
+						refreshed.Header[key] = values
+					}
+				}
+				refreshed.ExpiresAt = c.clock().Add(ttl)
+				c.Store.Set(key, &refreshed)
+				return refreshed.response(req), nil
+			}
+
+			if resp.StatusCode != http.StatusOK {
+				return resp, nil
+			}
+
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+
+			c.Store.Set(key, &CacheEntry{
+				Status:    resp.StatusCode,
+				Header:    resp.Header.Clone(),
+				Body:      body,
+				ExpiresAt: c.clock().Add(ttl),
+			})
+			return resp, nil
+		}
+	}
+}
+
+func (c *Cache) ttl(info RequestInfo) time.Duration {
+	resource, _ := resourceEndpoint(info.Method, info.Path)
+	if ttl, ok := c.TTLs[resource]; ok {
+		return ttl
+	}
+	return c.DefaultTTL
+}
+
+func (c *Cache) clock() time.Time {
+	if c.now != nil {
+		return c.now()
+	}
+	return time.Now()
+}
+
+// response returns a new response serving the cached entry
+func (e *CacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(e.Status),
+		StatusCode:    e.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// cacheResourcePrefix returns the key prefix of all entries of the resource
+// of a request, e.g. "fooshop.myshopify.com|admin/api/2024-01/|themes|"
+func cacheResourcePrefix(info RequestInfo) string {
+	resource, _ := resourceEndpoint(info.Method, info.Path)
+	version := apiPrefixRegex.FindString(info.Path)
+	return strings.Join([]string{info.Shop, version, resource, ""}, "|")
+}
+
+// cacheKey returns the key of a GET request, the query is normalized by
+// sorting its parameters. The identity comes after the resource prefix so a
+// write invalidates the entries of every token.
+func cacheKey(info RequestInfo, req *http.Request) string {
+	return cacheResourcePrefix(info) + cacheIdentity(req) + "|" + info.Path + "?" + req.URL.Query().Encode()
+}
+
+// cacheIdentity returns a hash of the credentials of a request
+func cacheIdentity(req *http.Request) string {
+	credentials := req.Header.Get("X-Shopify-Access-Token") + "|" + req.Header.Get("Authorization")
+	sum := sha256.Sum256([]byte(credentials))
+	return hex.EncodeToString(sum[:8])
+}
+
+// cachedResponse returns the response of a fresh cache entry of a GET request
+func (c *Client) cachedResponse(req *http.Request) (*http.Response, bool) {
+	if c.cache == nil || req.Method != http.MethodGet || req.URL == nil {
+		return nil, false
+	}
+	info := RequestInfo{Method: req.Method}
+	info.Shop, info.Path = c.requestTarget(req.URL)
+	if c.cache.ttl(info) <= 0 {
+		return nil, false
+	}
+
+	entry, ok := c.cache.Store.Get(cacheKey(info, req))
+	if !ok || !c.cache.clock().Before(entry.ExpiresAt) {
+		return nil, false
+	}
+	return entry.response(req), true
+}
+
+// MemoryCacheStore is an in-memory CacheStore evicting the least recently
+// used entries once its capacity is reached
+type MemoryCacheStore struct {
+	capacity int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+type memoryCacheItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewMemoryCacheStore returns an LRU store holding up to capacity entries
+func NewMemoryCacheStore(capacity int) *MemoryCacheStore {
+	if capacity <= 0 {
+		capacity = defaultCacheCapacity
+	}
+	return &MemoryCacheStore{
+		capacity: capacity,
+		entries:  map[string]*list.Element{},
+		lru:      list.New(),
+	}
+}
+
+// Get returns the entry stored under key and marks it as recently used
+func (s *MemoryCacheStore) Get(key string) (*CacheEntry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elem, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	s.lru.MoveToFront(elem)
+	return elem.Value.(*memoryCacheItem).entry, true
+}
+
+// Set stores the entry under key, evicting the least recently used entry if
+// the store is full
+func (s *MemoryCacheStore) Set(key string, entry *CacheEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if elem, ok := s.entries[key]; ok {
+		elem.Value.(*memoryCacheItem).entry = entry
+		s.lru.MoveToFront(elem)
+		return
+	}
+
+	s.entries[key] = s.lru.PushFront(&memoryCacheItem{key: key, entry: entry})
+	if s.lru.Len() > s.capacity {
+		oldest := s.lru.Back()
+		s.lru.Remove(oldest)
+		delete(s.entries, oldest.Value.(*memoryCacheItem).key)
+	}
+}
+
+// DeletePrefix removes all entries whose key starts with prefix
+func (s *MemoryCacheStore) DeletePrefix(prefix string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, elem := range s.entries {
+		if strings.HasPrefix(key, prefix) {
+			s.lru.Remove(elem)
+			delete(s.entries, key)
+		}
+	}
+}
+
+// Len returns the number of stored entries
+func (s *MemoryCacheStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lru.Len()
+}
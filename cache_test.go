@@ -0,0 +1,283 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func cacheSetup(cache *Cache) {
+	setup()
+	client.cache = cache
+}
+
+func TestWithCache(t *testing.T) {
+	cache := NewCache(nil)
+	c := NewClient(app, "fooshop", "abcd", WithCache(cache))
+	if c.cache != cache {
+		t.Errorf("WithCache client.cache = %v, expected %v", c.cache, cache)
+	}
+}
+
+func TestCacheServesFreshEntries(t *testing.T) {
+	cacheSetup(NewCache(nil))
+	defer teardown()
+
+	shopURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/shop.json", client.pathPrefix)
+	httpmock.RegisterResponder("GET", shopURL, httpmock.NewBytesResponder(200, loadFixture("shop.json")))
+
+	for i := 0; i < 3; i++ {
+		shop, err := client.Shop.Get(context.Background(), nil)
+		if err != nil {
+			t.Fatalf("Shop.Get returned error: %v", err)
+		}
+		if shop.ID != 690933842 {
+			t.Errorf("Shop.Get returned ID %d, expected 690933842", shop.ID)
+		}
+	}
+
+	if calls := httpmock.GetTotalCallCount(); calls != 1 {
+		t.Errorf("Shop.Get made %d requests, expected 1", calls)
+	}
+}
+
+func TestCacheRevalidatesWithETag(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewCache(nil)
+	cache.now = func() time.Time { return now }
+	cacheSetup(cache)
+	defer teardown()
+
+	var ifNoneMatch []string
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/locations.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			ifNoneMatch = append(ifNoneMatch, req.Header.Get("If-None-Match"))
+			if req.Header.Get("If-None-Match") == `"v1"` {
+				return httpmock.NewStringResponse(http.StatusNotModified, ""), nil
+			}
+			resp := httpmock.NewStringResponse(200, `{"locations":[{"id":1}]}`)
+			resp.Header.Set("ETag", `"v1"`)
+			return resp, nil
+		})
+
+	for i := 0; i < 2; i++ {
+		locations, err := client.Location.List(context.Background(), nil)
+		if err != nil {
+			t.Fatalf("Location.List returned error: %v", err)
+		}
+		if len(locations) != 1 || locations[0].ID != 1 {
+			t.Errorf("Location.List returned %+v, expected location 1", locations)
+		}
+		// expire the entry
+		now = now.Add(time.Hour)
+	}
+
+	expected := []string{"", `"v1"`}
+	if len(ifNoneMatch) != 2 || ifNoneMatch[0] != expected[0] || ifNoneMatch[1] != expected[1] {
+		t.Errorf("If-None-Match headers sent = %q, expected %q", ifNoneMatch, expected)
+	}
+}
+
+func TestCacheKeyIncludesQuery(t *testing.T) {
+	cacheSetup(NewCache(nil))
+	defer teardown()
+
+	themesURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/themes.json", client.pathPrefix)
+	httpmock.RegisterResponder("GET", themesURL, httpmock.NewStringResponder(200, `{"themes": [{"id":1},{"id":2}]}`))
+	httpmock.RegisterResponderWithQuery("GET", themesURL, map[string]string{"role": "main"}, httpmock.NewStringResponder(200, `{"themes": [{"id":1}]}`))
+
+	all, _ := client.Theme.List(context.Background(), nil)
+	main, _ := client.Theme.List(context.Background(), ThemeListOptions{Role: "main"})
+	if len(all) != 2 || len(main) != 1 {
+		t.Errorf("Theme.List returned %d and %d themes, expected 2 and 1", len(all), len(main))
+	}
+}
+
+func TestCacheInvalidatesOnWrite(t *testing.T) {
+	cacheSetup(NewCache(nil))
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/themes.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"themes": [{"id":1}]}`))
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/themes/1/assets.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"asset": {"key":"templates/index.liquid"}}`))
+
+	_, _ = client.Theme.List(context.Background(), nil)
+	_, _ = client.Theme.List(context.Background(), nil)
+	_, err := client.Asset.Update(context.Background(), 1, Asset{Key: "templates/index.liquid", Value: "foo"})
+	if err != nil {
+		t.Fatalf("Asset.Update returned error: %v", err)
+	}
+	_, _ = client.Theme.List(context.Background(), nil)
+
+	info := httpmock.GetCallCountInfo()
+	listKey := fmt.Sprintf("GET https://fooshop.myshopify.com/%s/themes.json", client.pathPrefix)
+	if info[listKey] != 2 {
+		t.Errorf("Theme.List made %d requests, expected 2", info[listKey])
+	}
+}
+
+func TestCacheSkipsResourcesWithoutTTL(t *testing.T) {
+	cacheSetup(NewCache(nil))
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products/1.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"product":{"id":1}}`))
+
+	_, _ = client.Product.Get(context.Background(), 1, nil)
+	_, _ = client.Product.Get(context.Background(), 1, nil)
+
+	if calls := httpmock.GetTotalCallCount(); calls != 2 {
+		t.Errorf("Product.Get made %d requests, expected 2", calls)
+	}
+}
+
+func TestCacheDoesNotStoreErrors(t *testing.T) {
+	cacheSetup(NewCache(nil))
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shop.json", client.pathPrefix),
+		httpmock.NewStringResponder(404, `{"errors":"Not Found"}`))
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.Shop.Get(context.Background(), nil); err == nil {
+			t.Errorf("Shop.Get expected error")
+		}
+	}
+
+	if calls := httpmock.GetTotalCallCount(); calls != 2 {
+		t.Errorf("Shop.Get made %d requests, expected 2", calls)
+	}
+}
+
+func TestCacheHitsSkipMetricsAndRateLimits(t *testing.T) {
+	cache := NewCache(nil)
+	metrics := &recordingMetrics{}
+	var infos []RequestInfo
+	record := func(next RequestHandler) RequestHandler {
+		return func(req *http.Request, info RequestInfo) (*http.Response, error) {
+			infos = append(infos, info)
+			return next(req, info)
+		}
+	}
+	setup()
+	defer teardown()
+	// the cache is given before the middleware
+	client = NewClient(app, "fooshop", "abcd", WithVersion(testApiVersion),
+		WithCache(cache), WithMiddleware(record), WithMetrics(metrics))
+	httpmock.ActivateNonDefault(client.Client)
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shop.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewBytesResponse(200, loadFixture("shop.json"))
+			resp.Header.Set("X-Shopify-Shop-Api-Call-Limit", "10/40")
+			return resp, nil
+		})
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products/1.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{"product":{"id":1}}`)
+			resp.Header.Set("X-Shopify-Shop-Api-Call-Limit", "20/40")
+			return resp, nil
+		})
+
+	if _, err := client.Shop.Get(context.Background(), nil); err != nil {
+		t.Fatalf("Shop.Get returned error: %v", err)
+	}
+	if _, err := client.Product.Get(context.Background(), 1, nil); err != nil {
+		t.Fatalf("Product.Get returned error: %v", err)
+	}
+	if _, err := client.Shop.Get(context.Background(), nil); err != nil {
+		t.Fatalf("Shop.Get returned error: %v", err)
+	}
+
+	if len(metrics.observed) != 2 || len(infos) != 2 {
+		t.Errorf("cache hit observed by %d metrics and %d middleware calls, expected 2", len(metrics.observed), len(infos))
+	}
+	if client.RateLimits.RequestCount != 20 {
+		t.Errorf("client.RateLimits.RequestCount = %d, expected 20 from the last request sent", client.RateLimits.RequestCount)
+	}
+}
+
+func TestCacheRevalidationUpdatesRateLimits(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewCache(nil)
+	cache.now = func() time.Time { return now }
+	cacheSetup(cache)
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/locations.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("If-None-Match") == `"v1"` {
+				resp := httpmock.NewStringResponse(http.StatusNotModified, "")
+				resp.Header.Set("X-Shopify-Shop-Api-Call-Limit", "2/40")
+				return resp, nil
+			}
+			resp := httpmock.NewStringResponse(200, `{"locations":[{"id":1}]}`)
+			resp.Header.Set("ETag", `"v1"`)
+			resp.Header.Set("X-Shopify-Shop-Api-Call-Limit", "1/40")
+			return resp, nil
+		})
+
+	_, _ = client.Location.List(context.Background(), nil)
+	now = now.Add(time.Hour)
+	locations, err := client.Location.List(context.Background(), nil)
+	if err != nil || len(locations) != 1 {
+		t.Fatalf("Location.List returned %+v, %v, expected the cached location", locations, err)
+	}
+	if client.RateLimits.RequestCount != 2 {
+		t.Errorf("client.RateLimits.RequestCount = %d, expected 2 from the 304 response", client.RateLimits.RequestCount)
+	}
+}
+
+func TestCacheKeyIncludesToken(t *testing.T) {
+	cache := NewCache(nil)
+	cacheSetup(cache)
+	defer teardown()
+	other := NewClient(app, "fooshop", "efgh", WithVersion(testApiVersion), WithCache(cache), WithHTTPClient(client.Client))
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shop.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("X-Shopify-Access-Token") != "abcd" {
+				return httpmock.NewStringResponse(http.StatusForbidden, `{"errors":"missing scope"}`), nil
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("shop.json")), nil
+		})
+
+	if _, err := client.Shop.Get(context.Background(), nil); err != nil {
+		t.Fatalf("Shop.Get returned error: %v", err)
+	}
+	if _, err := other.Shop.Get(context.Background(), nil); err == nil {
+		t.Errorf("Shop.Get of another token was served from the cache")
+	}
+}
+
+func TestMemoryCacheStore(t *testing.T) {
+	store := NewMemoryCacheStore(2)
+
+	store.Set("shop|a", &CacheEntry{Body: []byte("a")})
+	store.Set("shop|b", &CacheEntry{Body: []byte("b")})
+	// a is now the most recently used entry
+	if _, ok := store.Get("shop|a"); !ok {
+		t.Errorf("MemoryCacheStore.Get(shop|a) missing")
+	}
+	store.Set("other|c", &CacheEntry{Body: []byte("c")})
+
+	if _, ok := store.Get("shop|b"); ok {
+		t.Errorf("MemoryCacheStore kept the least recently used entry")
+	}
+	if store.Len() != 2 {
+		t.Errorf("MemoryCacheStore.Len() = %d, expected 2", store.Len())
+	}
+
+	store.DeletePrefix("shop|")
+	if _, ok := store.Get("shop|a"); ok {
+		t.Errorf("MemoryCacheStore.DeletePrefix kept shop|a")
+	}
+	if _, ok := store.Get("other|c"); !ok {
+		t.Errorf("MemoryCacheStore.DeletePrefix removed other|c")
+	}
+}
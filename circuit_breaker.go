@@ -0,0 +1,316 @@
+package goshopify
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultCircuitCooldown is how long a circuit stays open before a probe
+// request is let through
+const defaultCircuitCooldown = 30 * time.Second
+
+// ErrCircuitOpen matches the CircuitOpenError of the requests refused by a
+// CircuitBreaker
+var ErrCircuitOpen = errors.New("shopify: circuit breaker is open")
+
+// CircuitOpenError is returned for the requests to a shop whose circuit is
+// open, before anything is sent to Shopify
+type CircuitOpenError struct {
+	// Shop is the host of the shop, e.g. "fooshop.myshopify.com"
+	Shop string
+
+	State CircuitState
+
+	// Failure is the failure which opened the circuit
+	Failure CircuitFailure
+
+	// RetryAt is when a probe request will be let through
+	RetryAt time.Time
+}
+
+func (e CircuitOpenError) Error() string {
+	return fmt.Sprintf("shopify: circuit of %s is %s after %s failures, retry at %s",
+		e.Shop, e.State, e.Failure, e.RetryAt.Format(time.RFC3339))
+}
+
+// Is makes CircuitOpenError match ErrCircuitOpen
+func (e CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// CircuitState is the state of the circuit of a shop
+type CircuitState int
+
+const (
+	// CircuitClosed lets the requests through and counts the failures
+	CircuitClosed CircuitState = iota
+
+	// CircuitOpen refuses the requests with a CircuitOpenError
+	CircuitOpen
+
+	// CircuitHalfOpen lets a single probe request through, which closes the
+	// circuit if it succeeds and opens it again otherwise
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("CircuitState(%d)", int(s))
+}
+
+// CircuitFailure classifies the failed requests counted by a CircuitBreaker
+type CircuitFailure string
+
+const (
+	// CircuitUnavailable are the 5xx responses and the transport errors
+	CircuitUnavailable CircuitFailure = "unavailable"
+
+	// CircuitFrozen are the 402 responses of frozen shops
+	CircuitFrozen CircuitFailure = "frozen"
+
+	// CircuitLocked are the 423 responses of locked shops
+	CircuitLocked CircuitFailure = "locked"
+
+	// CircuitShopNotFound are the 404 responses without a JSON body, sent
+	// for the shops which don't exist rather than for missing resources
+	CircuitShopNotFound CircuitFailure = "shop_not_found"
+)
+
+// DefaultCircuitThresholds are the thresholds used by NewCircuitBreaker. A
+// shop is unavailable after 5 consecutive failed calls, frozen, locked or not
+// found after the first one.
+var DefaultCircuitThresholds = map[CircuitFailure]int{
+	CircuitUnavailable:  5,
+	CircuitFrozen:       1,
+	CircuitLocked:       1,
+	CircuitShopNotFound: 1,
+}
+
+// CircuitStateHandler is called when the circuit of a shop changes state
+type CircuitStateHandler func(shop string, from, to CircuitState)
+
+// CircuitBreaker stops sending requests to an unavailable shop. The
+// consecutive failures of a shop are counted by CircuitFailure, once per
+// call on its first attempt rather than on every retry. The circuit opens
+// when a count reaches its threshold and refuses the requests with a
+// CircuitOpenError, which also ends the retries. After Cooldown a single
+// probe request is let through to close or open the circuit again.
+// A CircuitBreaker can be shared by several clients, see WithCircuitBreaker.
+type CircuitBreaker struct {
+	// Thresholds are the numbers of consecutive failures opening the
+	// circuit, failures without a threshold are not counted
+	Thresholds map[CircuitFailure]int
+
+	// Cooldown is how long the circuit stays open, defaults to 30s
+	Cooldown time.Duration
+
+	// OnStateChange is called after the circuit of a shop changes state
+	OnStateChange CircuitStateHandler
+
+	mu       sync.Mutex
+	circuits map[string]*circuit
+
+	// Internal testing use only.
+	now func() time.Time
+}
+
+// circuit is the state of a shop
+type circuit struct {
+	state    CircuitState
+	failures map[CircuitFailure]int
+	failure  CircuitFailure
+	retryAt  time.Time
+	probing  bool
+}
+
+// NewCircuitBreaker returns a CircuitBreaker with DefaultCircuitThresholds
+func NewCircuitBreaker() *CircuitBreaker {
+	thresholds := make(map[CircuitFailure]int, len(DefaultCircuitThresholds))
+	for failure, threshold := range DefaultCircuitThresholds {
+		thresholds[failure] = threshold
+	}
+
+	return &CircuitBreaker{
+		Thresholds: thresholds,
+		Cooldown:   defaultCircuitCooldown,
+	}
+}
+
+// State returns the state of the circuit of a shop, e.g.
+// "fooshop.myshopify.com"
+func (b *CircuitBreaker) State(shop string) CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if c, ok := b.circuits[shop]; ok {
+		return c.state
+	}
+	return CircuitClosed
+}
+
+// Reset closes the circuit of a shop, e.g. after it was unfrozen
+func (b *CircuitBreaker) Reset(shop string) {
+	b.mu.Lock()
+	c, ok := b.circuits[shop]
+	delete(b.circuits, shop)
+	b.mu.Unlock()
+
+	if ok && c.state != CircuitClosed {
+		b.changed(shop, c.state, CircuitClosed)
+	}
+}
+
+// Middleware returns the middleware refusing the requests of the shops whose
+// circuit is open and counting the failures
+func (b *CircuitBreaker) Middleware() Middleware {
+	return func(next RequestHandler) RequestHandler {
+		return func(req *http.Request, info RequestInfo) (*http.Response, error) {
+			probe, err := b.allow(info.Shop)
+			if err != nil {
+				return nil, err
+			}
+
+			resp, err := next(req, info)
+			if info.Attempt > 1 && !probe && circuitFailure(resp, err) != "" {
+				// a call counts once, the failures of its retries are not
+				// counted
+				return resp, err
+			}
+			b.record(info.Shop, probe, req, resp, err)
+			return resp, err
+		}
+	}
+}
+
+// allow returns a CircuitOpenError if a request to a shop must not be sent,
+// probe is set if the request is the probe of a half-open circuit
+func (b *CircuitBreaker) allow(shop string) (probe bool, err error) {
+	b.mu.Lock()
+	c := b.circuit(shop)
+	from := c.state
+	switch {
+	case c.state == CircuitOpen && b.clock().Before(c.retryAt), c.state == CircuitHalfOpen && c.probing:
+		err = CircuitOpenError{Shop: shop, State: c.state, Failure: c.failure, RetryAt: c.retryAt}
+		b.mu.Unlock()
+		return false, err
+	case c.state == CircuitOpen:
+		// the cooldown is over, this request is the probe
+		c.state = CircuitHalfOpen
+		c.probing = true
+		probe = true
+	case c.state == CircuitHalfOpen:
+		// the previous probe was canceled
+		c.probing = true
+		probe = true
+	}
+	to := c.state
+	b.mu.Unlock()
+
+	if from != to {
+		b.changed(shop, from, to)
+	}
+	return probe, nil
+}
+
+// record counts the outcome of a request to a shop
+func (b *CircuitBreaker) record(shop string, probe bool, req *http.Request, resp *http.Response, err error) {
+	if err != nil && req.Context().Err() != nil {
+		// canceled by the caller, the shop may be fine
+		if probe {
+			b.mu.Lock()
+			b.circuit(shop).probing = false
+			b.mu.Unlock()
+		}
+		return
+	}
+
+	b.mu.Lock()
+	c := b.circuit(shop)
+	from := c.state
+	failure := circuitFailure(resp, err)
+	threshold := b.Thresholds[failure]
+	switch {
+	case failure == "" || threshold <= 0:
+		// the shop answered
+		c.state = CircuitClosed
+		c.failures = nil
+		c.failure = ""
+	default:
+		if c.failures == nil {
+			c.failures = make(map[CircuitFailure]int)
+		}
+		c.failures[failure]++
+		if c.state == CircuitHalfOpen || c.failures[failure] >= threshold {
+			c.state = CircuitOpen
+			c.failures = nil
+			c.failure = failure
+			c.retryAt = b.clock().Add(b.cooldown())
+		}
+	}
+	c.probing = false
+	to := c.state
+	b.mu.Unlock()
+
+	if from != to {
+		b.changed(shop, from, to)
+	}
+}
+
+// circuit returns the circuit of a shop, b.mu must be held
+func (b *CircuitBreaker) circuit(shop string) *circuit {
+	if b.circuits == nil {
+		b.circuits = make(map[string]*circuit)
+	}
+	c, ok := b.circuits[shop]
+	if !ok {
+		c = &circuit{}
+		b.circuits[shop] = c
+	}
+	return c
+}
+
+func (b *CircuitBreaker) changed(shop string, from, to CircuitState) {
+	if b.OnStateChange != nil {
+		b.OnStateChange(shop, from, to)
+	}
+}
+
+func (b *CircuitBreaker) cooldown() time.Duration {
+	if b.Cooldown <= 0 {
+		return defaultCircuitCooldown
+	}
+	return b.Cooldown
+}
+
+func (b *CircuitBreaker) clock() time.Time {
+	if b.now != nil {
+		return b.now()
+	}
+	return time.Now()
+}
+
+// circuitFailure returns the CircuitFailure of a response or transport error,
+// "" if the shop answered. The canceled requests are not recorded.
+func circuitFailure(resp *http.Response, err error) CircuitFailure {
+	switch {
+	case err != nil, resp.StatusCode >= http.StatusInternalServerError:
+		return CircuitUnavailable
+	case resp.StatusCode == http.StatusPaymentRequired:
+		return CircuitFrozen
+	case resp.StatusCode == http.StatusLocked:
+		return CircuitLocked
+	case resp.StatusCode == http.StatusNotFound && !strings.Contains(resp.Header.Get("Content-Type"), "json"):
+		return CircuitShopNotFound
+	}
+	return ""
+}
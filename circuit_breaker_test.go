@@ -0,0 +1,167 @@
+package goshopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	setup()
+	defer teardown()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var changes []string
+	breaker := NewCircuitBreaker()
+	breaker.now = func() time.Time { return now }
+	breaker.OnStateChange = func(shop string, from, to CircuitState) {
+		changes = append(changes, fmt.Sprintf("%s %s -> %s", shop, from, to))
+	}
+
+	// the clients share the breaker and the mocked http client
+	client = NewClient(app, "fooshop", "abcd", WithVersion(testApiVersion), WithRetry(maxRetries),
+		WithCircuitBreaker(breaker))
+	httpmock.ActivateNonDefault(client.Client)
+	other := NewClient(app, "fooshop", "efgh", WithVersion(testApiVersion), WithRetry(maxRetries),
+		WithCircuitBreaker(breaker), WithHTTPClient(client.Client))
+	ctx := context.Background()
+	shop := "fooshop.myshopify.com"
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products.json", client.pathPrefix),
+		httpmock.NewStringResponder(http.StatusServiceUnavailable, `{"errors":"Service Unavailable"}`))
+
+	// the calls count once whatever their retries, 4 calls of 3 attempts
+	// each don't open the circuit
+	for i := 0; i < 4; i++ {
+		c := client
+		if i%2 == 1 {
+			c = other
+		}
+		if _, err := c.Product.List(ctx, nil); errors.Is(err, ErrCircuitOpen) {
+			t.Errorf("Product.List returned %v, expected a service unavailable error", err)
+		}
+	}
+	if state := breaker.State(shop); state != CircuitClosed {
+		t.Errorf("CircuitBreaker.State = %s, expected closed", state)
+	}
+
+	// the first attempt of the fifth call opens the circuit and ends its
+	// retries
+	_, err := other.Product.List(ctx, nil)
+	if !errors.Is(err, ErrCircuitOpen) || ClassifyFailure(err) != FailureCircuitOpen {
+		t.Errorf("Product.List returned %v, expected ErrCircuitOpen", err)
+	}
+	var openErr CircuitOpenError
+	if !errors.As(err, &openErr) || openErr.Failure != CircuitUnavailable || !openErr.RetryAt.Equal(now.Add(defaultCircuitCooldown)) {
+		t.Errorf("Product.List returned %#v, expected a CircuitOpenError", err)
+	}
+	if _, err := client.Product.List(ctx, nil); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("Product.List returned %v, expected ErrCircuitOpen", err)
+	}
+	if calls := httpmock.GetTotalCallCount(); calls != 4*maxRetries+1 {
+		t.Errorf("expected %d calls, got %d", 4*maxRetries+1, calls)
+	}
+	if state := breaker.State(shop); state != CircuitOpen {
+		t.Errorf("CircuitBreaker.State = %s, expected open", state)
+	}
+
+	// the probe after the cooldown closes the circuit
+	now = now.Add(defaultCircuitCooldown)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products.json", client.pathPrefix),
+		httpmock.NewStringResponder(http.StatusOK, `{"products":[{"id":1}]}`))
+	if _, err := other.Product.List(ctx, nil); err != nil {
+		t.Errorf("Product.List returned error: %v", err)
+	}
+
+	expected := []string{
+		shop + " closed -> open",
+		shop + " open -> half-open",
+		shop + " half-open -> closed",
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("CircuitBreaker state changes = %v, expected %v", changes, expected)
+	}
+}
+
+func TestCircuitBreakerFailures(t *testing.T) {
+	cases := []struct {
+		status      int
+		contentType string
+		expected    CircuitState
+	}{
+		{http.StatusOK, "application/json", CircuitClosed},
+		{http.StatusTooManyRequests, "application/json", CircuitClosed},
+		{http.StatusNotFound, "application/json; charset=utf-8", CircuitClosed},
+		{http.StatusNotFound, "text/html", CircuitOpen},
+		{http.StatusPaymentRequired, "application/json", CircuitOpen},
+		{http.StatusLocked, "application/json", CircuitOpen},
+		{http.StatusBadGateway, "text/html", CircuitClosed},
+	}
+
+	for _, c := range cases {
+		breaker := NewCircuitBreaker()
+		handler := breaker.Middleware()(func(req *http.Request, info RequestInfo) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(c.status, "")
+			resp.Header.Set("Content-Type", c.contentType)
+			return resp, nil
+		})
+
+		req, _ := http.NewRequest("GET", "https://fooshop.myshopify.com/admin/shop.json", nil)
+		handler(req, RequestInfo{Shop: "fooshop.myshopify.com", Attempt: 2})
+		if state := breaker.State("fooshop.myshopify.com"); state != CircuitClosed {
+			t.Errorf("CircuitBreaker.State after a retry with %d %s = %s, expected closed", c.status, c.contentType, state)
+		}
+		handler(req, RequestInfo{Shop: "fooshop.myshopify.com", Attempt: 1})
+		if state := breaker.State("fooshop.myshopify.com"); state != c.expected {
+			t.Errorf("CircuitBreaker.State after %d %s = %s, expected %s", c.status, c.contentType, state, c.expected)
+		}
+		if state := breaker.State("barshop.myshopify.com"); state != CircuitClosed {
+			t.Errorf("CircuitBreaker.State of another shop = %s, expected closed", state)
+		}
+	}
+}
+
+func TestCircuitBreakerHalfOpen(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	breaker := &CircuitBreaker{
+		Thresholds: map[CircuitFailure]int{CircuitUnavailable: 1},
+		Cooldown:   time.Minute,
+		now:        func() time.Time { return now },
+	}
+	shop := "fooshop.myshopify.com"
+
+	// the transport errors count as unavailable
+	breaker.record(shop, false, &http.Request{}, nil, errors.New("connection refused"))
+	if state := breaker.State(shop); state != CircuitOpen {
+		t.Fatalf("CircuitBreaker.State = %s, expected open", state)
+	}
+
+	now = now.Add(time.Minute)
+	probe, err := breaker.allow(shop)
+	if !probe || err != nil {
+		t.Fatalf("CircuitBreaker.allow returned %v, %v, expected the probe", probe, err)
+	}
+	// a single probe is let through
+	if _, err := breaker.allow(shop); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("CircuitBreaker.allow returned %v during the probe, expected ErrCircuitOpen", err)
+	}
+
+	// a failed probe opens the circuit for another cooldown
+	breaker.record(shop, true, &http.Request{}, &http.Response{StatusCode: http.StatusInternalServerError}, nil)
+	_, err = breaker.allow(shop)
+	var openErr CircuitOpenError
+	if !errors.As(err, &openErr) || openErr.State != CircuitOpen || !openErr.RetryAt.Equal(now.Add(time.Minute)) {
+		t.Errorf("CircuitBreaker.allow returned %v, expected the circuit open for a minute", err)
+	}
+
+	breaker.Reset(shop)
+	if probe, err := breaker.allow(shop); probe || err != nil {
+		t.Errorf("CircuitBreaker.allow returned %v, %v after Reset, expected the circuit closed", probe, err)
+	}
+}
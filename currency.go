@@ -0,0 +1,42 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const currenciesBasePath = "currencies"
+
+// CurrencyService is an interface for interfacing with the currency endpoints
+// of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/currency
+type CurrencyService interface {
+	List(context.Context) ([]Currency, error)
+}
+
+// CurrencyServiceOp handles communication with the currency related methods
+// of the Shopify API.
+type CurrencyServiceOp struct {
+	client *Client
+}
+
+// Currency represents a presentment currency enabled on a Shopify shop
+type Currency struct {
+	Currency      string     `json:"currency,omitempty"`
+	RateUpdatedAt *time.Time `json:"rate_updated_at,omitempty"`
+	Enabled       bool       `json:"enabled,omitempty"`
+}
+
+// CurrenciesResource represents the result from the currencies.json endpoint
+type CurrenciesResource struct {
+	Currencies []Currency `json:"currencies"`
+}
+
+// List currencies
+func (s *CurrencyServiceOp) List(ctx context.Context) ([]Currency, error) {
+	path := fmt.Sprintf("%s.json", currenciesBasePath)
+	resource := new(CurrenciesResource)
+	err := s.client.Get(ctx, path, resource, nil)
+	return resource.Currencies, err
+}
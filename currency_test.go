@@ -0,0 +1,41 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestCurrencyList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/currencies.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("currencies.json")))
+
+	currencies, err := client.Currency.List(context.Background())
+	if err != nil {
+		t.Errorf("Currency.List returned error: %v", err)
+	}
+
+	if len(currencies) != 3 {
+		t.Fatalf("Currency.List got %v currencies, expected: 3", len(currencies))
+	}
+
+	expectedCodes := []string{"CAD", "EUR", "JPY"}
+	d := time.Date(2018, time.January, 24, 0, 1, 1, 0, time.UTC)
+	for i, currency := range currencies {
+		if currency.Currency != expectedCodes[i] {
+			t.Errorf("Currency.Currency returned %v, expected %v", currency.Currency, expectedCodes[i])
+		}
+		if !currency.Enabled {
+			t.Errorf("Currency.Enabled returned %v, expected true", currency.Enabled)
+		}
+		if !d.Equal(*currency.RateUpdatedAt) {
+			t.Errorf("Currency.RateUpdatedAt returned %+v, expected %+v", currency.RateUpdatedAt, d)
+		}
+	}
+}
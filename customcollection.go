@@ -16,8 +16,10 @@ type CustomCollectionService interface {
 	List(context.Context, interface{}) ([]CustomCollection, error)
 	Count(context.Context, interface{}) (int, error)
 	Get(context.Context, int64, interface{}) (*CustomCollection, error)
+	GetMany(context.Context, []int64) (*GetManyResult[CustomCollection], error)
 	Create(context.Context, CustomCollection) (*CustomCollection, error)
 	Update(context.Context, CustomCollection) (*CustomCollection, error)
+	Patch(context.Context, int64, *Patch[CustomCollection]) (*CustomCollection, error)
 	Delete(context.Context, int64) error
 
 	// MetafieldsService used for CustomCollection resource to communicate with Metafields resource
@@ -79,6 +81,14 @@ func (s *CustomCollectionServiceOp) Get(ctx context.Context, collectionID int64,
 	return resource.Collection, err
 }
 
+// GetMany gets custom collections by id, in chunks of 250 ids
+func (s *CustomCollectionServiceOp) GetMany(ctx context.Context, ids []int64) (*GetManyResult[CustomCollection], error) {
+	path := fmt.Sprintf("%s.json", customCollectionsBasePath)
+	return getMany(ctx, s.client, path, "custom_collections", ids, getManyChunkSize, "", func(resource CustomCollection) int64 {
+		return resource.ID
+	})
+}
+
 // Create a new custom collection
 // See Image for the details of the Image creation for a collection.
 func (s *CustomCollectionServiceOp) Create(ctx context.Context, collection CustomCollection) (*CustomCollection, error) {
@@ -98,6 +108,19 @@ func (s *CustomCollectionServiceOp) Update(ctx context.Context, collection Custo
 	return resource.Collection, err
 }
 
+// Patch updates only the fields set in the patch of an existing custom collection
+func (s *CustomCollectionServiceOp) Patch(ctx context.Context, collectionID int64, patch *Patch[CustomCollection]) (*CustomCollection, error) {
+	body, err := patch.body(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("%s/%d.json", customCollectionsBasePath, collectionID)
+	wrappedData := map[string]interface{}{"custom_collection": body}
+	resource := new(CustomCollectionResource)
+	err = s.client.Put(ctx, path, wrappedData, resource)
+	return resource.Collection, err
+}
+
 // Delete an existing custom collection.
 func (s *CustomCollectionServiceOp) Delete(ctx context.Context, collectionID int64) error {
 	return s.client.Delete(ctx, fmt.Sprintf("%s/%d.json", customCollectionsBasePath, collectionID))
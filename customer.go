@@ -17,14 +17,19 @@ const customersResourceName = "customers"
 type CustomerService interface {
 	List(context.Context, interface{}) ([]Customer, error)
 	GetBySinceId(ctx context.Context, sinceId int64, limit int, options interface{}) ([]Customer, error)
+	StreamCustomers(context.Context, interface{}, func(Customer) error) error
 	Count(context.Context, interface{}) (int, error)
 	Get(context.Context, int64, interface{}) (*Customer, error)
+	GetMany(context.Context, []int64) (*GetManyResult[Customer], error)
 	Search(context.Context, interface{}) ([]Customer, error)
 	Create(context.Context, Customer) (*Customer, error)
 	Update(context.Context, Customer) (*Customer, error)
+	Patch(context.Context, int64, *Patch[Customer]) (*Customer, error)
 	Delete(context.Context, int64) error
 	ListOrders(context.Context, int64, interface{}) ([]Order, error)
 	ListTags(context.Context, interface{}) ([]string, error)
+	AccountActivationURL(context.Context, int64) (string, error)
+	SendInvite(context.Context, int64, CustomerInvite) (*CustomerInvite, error)
 
 	// MetafieldsService used for Customer resource to communicate with Metafields resource
 	MetafieldsService
@@ -78,6 +83,26 @@ type CustomerTagsResource struct {
 	Tags []string `json:"tags"`
 }
 
+// CustomerInvite represents the invite email sent to a customer to activate
+// their account
+type CustomerInvite struct {
+	To            string   `json:"to,omitempty"`
+	From          string   `json:"from,omitempty"`
+	Bcc           []string `json:"bcc,omitempty"`
+	Subject       string   `json:"subject,omitempty"`
+	CustomMessage string   `json:"custom_message,omitempty"`
+}
+
+// Represents the result from the customers/X/send_invite.json endpoint
+type CustomerInviteResource struct {
+	CustomerInvite *CustomerInvite `json:"customer_invite"`
+}
+
+// Represents the result from the customers/X/account_activation_url.json endpoint
+type CustomerAccountActivationURLResource struct {
+	AccountActivationURL string `json:"account_activation_url"`
+}
+
 // Represents the options available when searching for a customer
 type CustomerSearchOptions struct {
 	Page   int    `url:"page,omitempty"`
@@ -102,6 +127,16 @@ func (s *CustomerServiceOp) GetBySinceId(ctx context.Context, sinceId int64, lim
 	return resource.Customers, err
 }
 
+// StreamCustomers lists customers across all pages and calls fn with each
+// customer as it is decoded, without holding a whole page in memory.
+// It stops at the first error returned by fn and returns that error.
+// The time spent in fn counts toward the http client Timeout, see
+// StreamProducts.
+func (s *CustomerServiceOp) StreamCustomers(ctx context.Context, options interface{}, fn func(Customer) error) error {
+	path := fmt.Sprintf("%s.json", customersBasePath)
+	return streamList(ctx, s.client, path, "customers", options, fn)
+}
+
 // Count customers
 func (s *CustomerServiceOp) Count(ctx context.Context, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/count.json", customersBasePath)
@@ -116,6 +151,14 @@ func (s *CustomerServiceOp) Get(ctx context.Context, customerID int64, options i
 	return resource.Customer, err
 }
 
+// GetMany gets customers by id, in chunks of 250 ids
+func (s *CustomerServiceOp) GetMany(ctx context.Context, ids []int64) (*GetManyResult[Customer], error) {
+	path := fmt.Sprintf("%s.json", customersBasePath)
+	return getMany(ctx, s.client, path, "customers", ids, getManyChunkSize, "", func(resource Customer) int64 {
+		return resource.ID
+	})
+}
+
 // Create a new customer
 func (s *CustomerServiceOp) Create(ctx context.Context, customer Customer) (*Customer, error) {
 	path := fmt.Sprintf("%s.json", customersBasePath)
@@ -134,6 +177,19 @@ func (s *CustomerServiceOp) Update(ctx context.Context, customer Customer) (*Cus
 	return resource.Customer, err
 }
 
+// Patch updates only the fields set in the patch of an existing customer
+func (s *CustomerServiceOp) Patch(ctx context.Context, customerID int64, patch *Patch[Customer]) (*Customer, error) {
+	body, err := patch.body(customerID)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("%s/%d.json", customersBasePath, customerID)
+	wrappedData := map[string]interface{}{"customer": body}
+	resource := new(CustomerResource)
+	err = s.client.Put(ctx, path, wrappedData, resource)
+	return resource.Customer, err
+}
+
 // Delete an existing customer
 func (s *CustomerServiceOp) Delete(ctx context.Context, customerID int64) error {
 	path := fmt.Sprintf("%s/%d.json", customersBasePath, customerID)
@@ -164,6 +220,25 @@ func (s *CustomerServiceOp) ListTags(ctx context.Context, options interface{}) (
 	return resource.Tags, err
 }
 
+// AccountActivationURL generates a one-time account activation URL for a
+// customer whose account is not yet enabled
+func (s *CustomerServiceOp) AccountActivationURL(ctx context.Context, customerID int64) (string, error) {
+	path := fmt.Sprintf("%s/%d/account_activation_url.json", customersBasePath, customerID)
+	resource := new(CustomerAccountActivationURLResource)
+	err := s.client.Post(ctx, path, nil, resource)
+	return resource.AccountActivationURL, err
+}
+
+// SendInvite sends an account invite email to a customer. An empty invite
+// sends Shopify's default invite email.
+func (s *CustomerServiceOp) SendInvite(ctx context.Context, customerID int64, invite CustomerInvite) (*CustomerInvite, error) {
+	path := fmt.Sprintf("%s/%d/send_invite.json", customersBasePath, customerID)
+	wrappedData := CustomerInviteResource{CustomerInvite: &invite}
+	resource := new(CustomerInviteResource)
+	err := s.client.Post(ctx, path, wrappedData, resource)
+	return resource.CustomerInvite, err
+}
+
 // List metafields for a customer
 func (s *CustomerServiceOp) ListMetafields(ctx context.Context, customerID int64, options interface{}) ([]Metafield, error) {
 	metafieldService := &MetafieldServiceOp{client: s.client, resource: customersResourceName, resourceID: customerID}
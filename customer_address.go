@@ -16,6 +16,8 @@ type CustomerAddressService interface {
 	Create(context.Context, int64, CustomerAddress) (*CustomerAddress, error)
 	Update(context.Context, int64, CustomerAddress) (*CustomerAddress, error)
 	Delete(context.Context, int64, int64) error
+	SetDefault(context.Context, int64, int64) (*CustomerAddress, error)
+	DeleteMultiple(context.Context, int64, []int64) error
 }
 
 // CustomerAddressServiceOp handles communication with the customer address related methods of
@@ -56,6 +58,13 @@ type CustomerAddressesResource struct {
 	Addresses []CustomerAddress `json:"addresses"`
 }
 
+// customerAddressSetOptions represents the query of the
+// customers/X/addresses/set.json endpoint
+type customerAddressSetOptions struct {
+	AddressIDs []int64 `url:"address_ids,brackets"`
+	Operation  string  `url:"operation"`
+}
+
 // List addresses
 func (s *CustomerAddressServiceOp) List(ctx context.Context, customerID int64, options interface{}) ([]CustomerAddress, error) {
 	path := fmt.Sprintf("%s/%d/addresses.json", customersBasePath, customerID)
@@ -94,3 +103,18 @@ func (s *CustomerAddressServiceOp) Update(ctx context.Context, customerID int64,
 func (s *CustomerAddressServiceOp) Delete(ctx context.Context, customerID, addressID int64) error {
 	return s.client.Delete(ctx, fmt.Sprintf("%s/%d/addresses/%d.json", customersBasePath, customerID, addressID))
 }
+
+// SetDefault sets an existing address as the customer's default address
+func (s *CustomerAddressServiceOp) SetDefault(ctx context.Context, customerID, addressID int64) (*CustomerAddress, error) {
+	path := fmt.Sprintf("%s/%d/addresses/%d/default.json", customersBasePath, customerID, addressID)
+	resource := new(CustomerAddressResource)
+	err := s.client.Put(ctx, path, nil, resource)
+	return resource.Address, err
+}
+
+// DeleteMultiple deletes several addresses of a customer in a single request
+func (s *CustomerAddressServiceOp) DeleteMultiple(ctx context.Context, customerID int64, addressIDs []int64) error {
+	path := fmt.Sprintf("%s/%d/addresses/set.json", customersBasePath, customerID)
+	options := customerAddressSetOptions{AddressIDs: addressIDs, Operation: "destroy"}
+	return s.client.CreateAndDo(ctx, "PUT", path, nil, options, nil)
+}
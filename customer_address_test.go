@@ -165,3 +165,31 @@ func TestDelete(t *testing.T) {
 		t.Errorf("CustomerAddress.Update returned error: %v", err)
 	}
 }
+
+func TestSetDefault(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers/1/addresses/1/default.json", client.pathPrefix), httpmock.NewBytesResponder(200, loadFixture("customer_address.json")))
+
+	address, err := client.CustomerAddress.SetDefault(context.Background(), 1, 1)
+	if err != nil {
+		t.Errorf("CustomerAddress.SetDefault returned error: %v", err)
+	}
+
+	verifyAddress(t, *address)
+}
+
+func TestDeleteMultiple(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponderWithQuery("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers/1/addresses/set.json", client.pathPrefix),
+		"address_ids%5B%5D=1&address_ids%5B%5D=2&operation=destroy",
+		httpmock.NewStringResponder(200, "{}"))
+
+	err := client.CustomerAddress.DeleteMultiple(context.Background(), 1, []int64{1, 2})
+	if err != nil {
+		t.Errorf("CustomerAddress.DeleteMultiple returned error: %v", err)
+	}
+}
@@ -486,3 +486,49 @@ func TestCustomerListTags(t *testing.T) {
 		t.Errorf("Customer.ListTags got %v as the first tag, expected: 'tag1'", tags[0])
 	}
 }
+
+func TestCustomerAccountActivationURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers/1/account_activation_url.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"account_activation_url": "https://fooshop.myshopify.com/account/activate/1/abc-123"}`))
+
+	url, err := client.Customer.AccountActivationURL(context.Background(), 1)
+	if err != nil {
+		t.Errorf("Customer.AccountActivationURL returned error: %v", err)
+	}
+
+	expected := "https://fooshop.myshopify.com/account/activate/1/abc-123"
+	if url != expected {
+		t.Errorf("Customer.AccountActivationURL returned %+v, expected %+v", url, expected)
+	}
+}
+
+func TestCustomerSendInvite(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers/1/send_invite.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("customer_invite.json")))
+
+	invite := CustomerInvite{
+		To:            "new_test_email@shopify.com",
+		From:          "j.limited@example.com",
+		Subject:       "Welcome to my new shop",
+		CustomMessage: "My awesome new store",
+	}
+
+	returnedInvite, err := client.Customer.SendInvite(context.Background(), 1, invite)
+	if err != nil {
+		t.Errorf("Customer.SendInvite returned error: %v", err)
+	}
+
+	if returnedInvite.To != invite.To {
+		t.Errorf("CustomerInvite.To returned %+v, expected %+v", returnedInvite.To, invite.To)
+	}
+
+	if returnedInvite.Subject != invite.Subject {
+		t.Errorf("CustomerInvite.Subject returned %+v, expected %+v", returnedInvite.Subject, invite.Subject)
+	}
+}
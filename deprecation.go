@@ -0,0 +1,94 @@
+package goshopify
+
+import (
+	"net/http"
+	"sort"
+	"time"
+)
+
+// deprecatedReasonHeader is set by Shopify on the responses of calls to
+// deprecated endpoints or fields
+const deprecatedReasonHeader = "X-Shopify-API-Deprecated-Reason"
+
+// Deprecation aggregates the calls to an endpoint that Shopify reported as
+// deprecated in the X-Shopify-API-Deprecated-Reason header
+type Deprecation struct {
+	// Endpoint is the method and path of the calls without the api prefix
+	// and with IDs replaced, e.g. "GET products/:id.json"
+	Endpoint string
+
+	// Version is the api version the calls were served with
+	Version string
+
+	// Reason is the value of the header, usually a link to the changelog
+	Reason string
+
+	Count     int
+	FirstSeen time.Time
+	LastSeen  time.Time
+}
+
+// DeprecationHandler is called with the updated Deprecation after every
+// deprecated call, see WithDeprecationHandler
+type DeprecationHandler func(Deprecation)
+
+// Deprecations returns the deprecated calls made by the client so far,
+// ordered by endpoint
+func (c *Client) Deprecations() []Deprecation {
+	c.deprecationMu.Lock()
+	defer c.deprecationMu.Unlock()
+
+	report := make([]Deprecation, 0, len(c.deprecations))
+	for _, d := range c.deprecations {
+		report = append(report, *d)
+	}
+	sort.Slice(report, func(i, j int) bool {
+		if report[i].Endpoint != report[j].Endpoint {
+			return report[i].Endpoint < report[j].Endpoint
+		}
+		if report[i].Version != report[j].Version {
+			return report[i].Version < report[j].Version
+		}
+		return report[i].Reason < report[j].Reason
+	})
+	return report
+}
+
+// observeDeprecation adds a response flagged as deprecated to the report
+// and calls the deprecation handler
+func (c *Client) observeDeprecation(req *http.Request, resp *http.Response) {
+	reason := resp.Header.Get(deprecatedReasonHeader)
+	if reason == "" {
+		return
+	}
+
+	version := resp.Header.Get("X-Shopify-API-Version")
+	if version == "" {
+		version, _ = c.requestVersion(req.Context())
+	}
+	_, path := c.requestTarget(req.URL)
+	_, endpoint := resourceEndpoint(req.Method, path)
+	key := endpoint + "|" + version + "|" + reason
+	now := time.Now()
+
+	c.deprecationMu.Lock()
+	if c.deprecations == nil {
+		c.deprecations = make(map[string]*Deprecation)
+	}
+	d, ok := c.deprecations[key]
+	if !ok {
+		d = &Deprecation{Endpoint: endpoint, Version: version, Reason: reason, FirstSeen: now}
+		c.deprecations[key] = d
+	}
+	d.Count++
+	d.LastSeen = now
+	report := *d
+	c.deprecationMu.Unlock()
+
+	if !ok {
+		c.log.Warnf("deprecated call %s on api version %s: %s", endpoint, version, reason)
+	}
+	if c.deprecationHandler != nil {
+		c.deprecationHandler(report)
+	}
+}
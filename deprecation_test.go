@@ -0,0 +1,63 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestDeprecationReport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var handled []Deprecation
+	WithDeprecationHandler(func(d Deprecation) {
+		handled = append(handled, d)
+	})(client)
+
+	reason := "https://shopify.dev/changelog/deprecated-field"
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products/1.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{"product":{"id":1}}`)
+			resp.Header.Set("X-Shopify-API-Version", "2023-07")
+			resp.Header.Set("X-Shopify-API-Deprecated-Reason", reason)
+			return resp, nil
+		})
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products/2.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{"product":{"id":2}}`)
+			resp.Header.Set("X-Shopify-API-Version", "2023-07")
+			resp.Header.Set("X-Shopify-API-Deprecated-Reason", reason)
+			return resp, nil
+		})
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shop.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"shop":{"id":1}}`))
+
+	for _, id := range []int64{1, 2} {
+		if _, err := client.Product.Get(context.Background(), id, nil); err != nil {
+			t.Fatalf("Product.Get returned error: %v", err)
+		}
+	}
+	if _, err := client.Shop.Get(context.Background(), nil); err != nil {
+		t.Fatalf("Shop.Get returned error: %v", err)
+	}
+
+	report := client.Deprecations()
+	if len(report) != 1 {
+		t.Fatalf("Client.Deprecations returned %d deprecations, expected 1", len(report))
+	}
+	d := report[0]
+	if d.Endpoint != "GET products/:id.json" || d.Version != "2023-07" || d.Reason != reason || d.Count != 2 {
+		t.Errorf("Client.Deprecations returned %+v, expected 2 deprecated product gets", d)
+	}
+	if d.FirstSeen.IsZero() || d.LastSeen.Before(d.FirstSeen) {
+		t.Errorf("Deprecation seen from %s to %s", d.FirstSeen, d.LastSeen)
+	}
+
+	if len(handled) != 2 || handled[0].Count != 1 || handled[1].Count != 2 {
+		t.Errorf("deprecation handler called with %+v, expected counts 1 and 2", handled)
+	}
+}
@@ -6,7 +6,21 @@ import (
 	"time"
 )
 
-const discountCodeBasePath = "price_rules/%d/discount_codes"
+const (
+	discountCodeBasePath      = "price_rules/%d/discount_codes"
+	discountCodeBatchBasePath = "price_rules/%d/batch"
+
+	// discountCodeBatchLimit is the maximum number of codes Shopify accepts
+	// in a single batch job
+	discountCodeBatchLimit = 100
+
+	// DiscountCodeBatchStatusCompleted is the status of a finished batch job
+	DiscountCodeBatchStatusCompleted = "completed"
+)
+
+// discountCodeBatchPollInterval is the delay between two batch job status
+// checks in WaitForBatch
+var discountCodeBatchPollInterval = 2 * time.Second
 
 // DiscountCodeService is an interface for interfacing with the discount endpoints
 // of the Shopify API.
@@ -17,6 +31,11 @@ type DiscountCodeService interface {
 	List(context.Context, int64) ([]PriceRuleDiscountCode, error)
 	Get(context.Context, int64, int64) (*PriceRuleDiscountCode, error)
 	Delete(context.Context, int64, int64) error
+	Lookup(context.Context, string) (*PriceRuleDiscountCode, error)
+	CreateBatch(context.Context, int64, []string) ([]DiscountCodeCreation, error)
+	GetBatch(context.Context, int64, int64) (*DiscountCodeCreation, error)
+	ListBatchCodes(context.Context, int64, int64) ([]PriceRuleDiscountCode, error)
+	WaitForBatch(context.Context, int64, int64) (*DiscountCodeCreation, []PriceRuleDiscountCode, error)
 }
 
 // DiscountCodeServiceOp handles communication with the discount code
@@ -33,6 +52,35 @@ type PriceRuleDiscountCode struct {
 	UsageCount  int        `json:"usage_count,omitempty"`
 	CreatedAt   *time.Time `json:"created_at,omitempty"`
 	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+
+	// Errors is only set on codes of a batch job which could not be created
+	Errors map[string][]string `json:"errors,omitempty"`
+}
+
+// DiscountCodeCreation represents a Shopify discount code batch job
+type DiscountCodeCreation struct {
+	ID            int64      `json:"id,omitempty"`
+	PriceRuleID   int64      `json:"price_rule_id,omitempty"`
+	StartedAt     *time.Time `json:"started_at,omitempty"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+	Status        string     `json:"status,omitempty"`
+	CodesCount    int        `json:"codes_count,omitempty"`
+	ImportedCount int        `json:"imported_count,omitempty"`
+	FailedCount   int        `json:"failed_count,omitempty"`
+	Logs          []string   `json:"logs,omitempty"`
+}
+
+// DiscountCodeCreationResource represents the result from the batch.json and
+// batch/X.json endpoints
+type DiscountCodeCreationResource struct {
+	DiscountCodeCreation *DiscountCodeCreation `json:"discount_code_creation"`
+}
+
+// discountCodeLookupOptions represents the query of the discount_codes/lookup.json endpoint
+type discountCodeLookupOptions struct {
+	Code string `url:"code"`
 }
 
 // DiscountCodesResource is the result from the discount_codes.json endpoint
@@ -83,3 +131,82 @@ func (s *DiscountCodeServiceOp) Get(ctx context.Context, priceRuleID int64, disc
 func (s *DiscountCodeServiceOp) Delete(ctx context.Context, priceRuleID int64, discountCodeID int64) error {
 	return s.client.Delete(ctx, fmt.Sprintf(discountCodeBasePath+"/%d.json", priceRuleID, discountCodeID))
 }
+
+// Lookup retrieves the location of a discount code by its code text.
+// Shopify answers with a redirect to the discount code which is followed.
+func (s *DiscountCodeServiceOp) Lookup(ctx context.Context, code string) (*PriceRuleDiscountCode, error) {
+	resource := new(DiscountCodeResource)
+	err := s.client.Get(ctx, "discount_codes/lookup.json", resource, discountCodeLookupOptions{Code: code})
+	return resource.PriceRuleDiscountCode, err
+}
+
+// CreateBatch creates discount codes asynchronously using batch jobs.
+// Codes are split into jobs of at most 100 codes each, one job is returned
+// per chunk in the same order.
+func (s *DiscountCodeServiceOp) CreateBatch(ctx context.Context, priceRuleID int64, codes []string) ([]DiscountCodeCreation, error) {
+	path := fmt.Sprintf(discountCodeBatchBasePath+".json", priceRuleID)
+	batches := make([]DiscountCodeCreation, 0, (len(codes)+discountCodeBatchLimit-1)/discountCodeBatchLimit)
+
+	for start := 0; start < len(codes); start += discountCodeBatchLimit {
+		end := start + discountCodeBatchLimit
+		if end > len(codes) {
+			end = len(codes)
+		}
+
+		wrappedData := DiscountCodesResource{DiscountCodes: make([]PriceRuleDiscountCode, 0, end-start)}
+		for _, code := range codes[start:end] {
+			wrappedData.DiscountCodes = append(wrappedData.DiscountCodes, PriceRuleDiscountCode{Code: code})
+		}
+
+		resource := new(DiscountCodeCreationResource)
+		err := s.client.Post(ctx, path, wrappedData, resource)
+		if err != nil {
+			return batches, err
+		}
+		if resource.DiscountCodeCreation != nil {
+			batches = append(batches, *resource.DiscountCodeCreation)
+		}
+	}
+
+	return batches, nil
+}
+
+// GetBatch retrieves a discount code batch job
+func (s *DiscountCodeServiceOp) GetBatch(ctx context.Context, priceRuleID int64, batchID int64) (*DiscountCodeCreation, error) {
+	path := fmt.Sprintf(discountCodeBatchBasePath+"/%d.json", priceRuleID, batchID)
+	resource := new(DiscountCodeCreationResource)
+	err := s.client.Get(ctx, path, resource, nil)
+	return resource.DiscountCodeCreation, err
+}
+
+// ListBatchCodes lists the discount codes of a batch job. Codes which could
+// not be created have their Errors set.
+func (s *DiscountCodeServiceOp) ListBatchCodes(ctx context.Context, priceRuleID int64, batchID int64) ([]PriceRuleDiscountCode, error) {
+	path := fmt.Sprintf(discountCodeBatchBasePath+"/%d/discount_codes.json", priceRuleID, batchID)
+	resource := new(DiscountCodesResource)
+	err := s.client.Get(ctx, path, resource, nil)
+	return resource.DiscountCodes, err
+}
+
+// WaitForBatch polls a discount code batch job until it is completed or ctx
+// is done. It returns the completed job and its discount codes, including
+// the per-code errors of codes which could not be created.
+func (s *DiscountCodeServiceOp) WaitForBatch(ctx context.Context, priceRuleID int64, batchID int64) (*DiscountCodeCreation, []PriceRuleDiscountCode, error) {
+	for {
+		batch, err := s.GetBatch(ctx, priceRuleID, batchID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if batch != nil && batch.Status == DiscountCodeBatchStatusCompleted {
+			codes, err := s.ListBatchCodes(ctx, priceRuleID, batchID)
+			return batch, codes, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return batch, nil, ctx.Err()
+		case <-time.After(discountCodeBatchPollInterval):
+		}
+	}
+}
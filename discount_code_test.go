@@ -2,8 +2,13 @@ package goshopify
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/jarcoal/httpmock"
 )
@@ -129,3 +134,173 @@ func TestDiscountCodeDelete(t *testing.T) {
 		t.Errorf("DiscountCode.Delete returned error: %v", err)
 	}
 }
+
+func TestDiscountCodeLookup(t *testing.T) {
+	setup()
+	defer teardown()
+
+	location := fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/discount_codes/1054381139.json", client.pathPrefix)
+	httpmock.RegisterResponderWithQuery(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/discount_codes/lookup.json", client.pathPrefix),
+		"code=SUMMERSALE10OFF",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusSeeOther, "")
+			resp.Header.Set("Location", location)
+			return resp, nil
+		},
+	)
+	httpmock.RegisterResponder("GET", location, httpmock.NewBytesResponder(200, loadFixture("discount_code.json")))
+
+	dc, err := client.DiscountCode.Lookup(context.Background(), "SUMMERSALE10OFF")
+	if err != nil {
+		t.Fatalf("DiscountCode.Lookup returned error: %v", err)
+	}
+
+	if dc.ID != 1054381139 || dc.Code != "SUMMERSALE10OFF" {
+		t.Errorf("DiscountCode.Lookup returned %+v, expected ID 1054381139", dc)
+	}
+}
+
+func TestDiscountCodeCreateBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requestSizes []int
+	httpmock.RegisterResponder(
+		"POST",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/batch.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			body := DiscountCodesResource{}
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Errorf("DiscountCode.CreateBatch sent invalid body: %v", err)
+			}
+			requestSizes = append(requestSizes, len(body.DiscountCodes))
+			return httpmock.NewBytesResponse(201, loadFixture("discount_code_creation.json")), nil
+		},
+	)
+
+	codes := make([]string, 250)
+	for i := range codes {
+		codes[i] = fmt.Sprintf("CODE%d", i)
+	}
+
+	batches, err := client.DiscountCode.CreateBatch(context.Background(), 507328175, codes)
+	if err != nil {
+		t.Fatalf("DiscountCode.CreateBatch returned error: %v", err)
+	}
+
+	expectedSizes := []int{100, 100, 50}
+	if !reflect.DeepEqual(requestSizes, expectedSizes) {
+		t.Errorf("DiscountCode.CreateBatch sent batches of %v, expected %v", requestSizes, expectedSizes)
+	}
+
+	if len(batches) != 3 || batches[0].ID != 989355119 || batches[0].Status != "queued" {
+		t.Errorf("DiscountCode.CreateBatch returned %+v, expected 3 queued batches", batches)
+	}
+}
+
+func TestDiscountCodeGetBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/batch/989355119.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("discount_code_creation.json")),
+	)
+
+	batch, err := client.DiscountCode.GetBatch(context.Background(), 507328175, 989355119)
+	if err != nil {
+		t.Fatalf("DiscountCode.GetBatch returned error: %v", err)
+	}
+
+	if batch.ID != 989355119 || batch.CodesCount != 3 {
+		t.Errorf("DiscountCode.GetBatch returned %+v, expected ID 989355119 with 3 codes", batch)
+	}
+}
+
+func TestDiscountCodeListBatchCodes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/batch/989355119/discount_codes.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("discount_code_batch_codes.json")),
+	)
+
+	codes, err := client.DiscountCode.ListBatchCodes(context.Background(), 507328175, 989355119)
+	if err != nil {
+		t.Fatalf("DiscountCode.ListBatchCodes returned error: %v", err)
+	}
+
+	if len(codes) != 3 {
+		t.Fatalf("DiscountCode.ListBatchCodes got %v codes, expected 3", len(codes))
+	}
+
+	expectedErrors := map[string][]string{"code": {"must be unique. Please try a different code."}}
+	if !reflect.DeepEqual(codes[2].Errors, expectedErrors) {
+		t.Errorf("DiscountCode.ListBatchCodes errors returned %+v, expected %+v", codes[2].Errors, expectedErrors)
+	}
+}
+
+func TestDiscountCodeWaitForBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	defer func(interval time.Duration) { discountCodeBatchPollInterval = interval }(discountCodeBatchPollInterval)
+	discountCodeBatchPollInterval = time.Millisecond
+
+	polls := 0
+	httpmock.RegisterResponder(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/batch/989355119.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			polls++
+			if polls < 3 {
+				return httpmock.NewBytesResponse(200, loadFixture("discount_code_creation.json")), nil
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("discount_code_creation_completed.json")), nil
+		},
+	)
+	httpmock.RegisterResponder(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/batch/989355119/discount_codes.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("discount_code_batch_codes.json")),
+	)
+
+	batch, codes, err := client.DiscountCode.WaitForBatch(context.Background(), 507328175, 989355119)
+	if err != nil {
+		t.Fatalf("DiscountCode.WaitForBatch returned error: %v", err)
+	}
+
+	if polls != 3 {
+		t.Errorf("DiscountCode.WaitForBatch polled %v times, expected 3", polls)
+	}
+	if batch.Status != DiscountCodeBatchStatusCompleted || batch.FailedCount != 1 {
+		t.Errorf("DiscountCode.WaitForBatch returned %+v, expected a completed batch with 1 failure", batch)
+	}
+	if len(codes) != 3 {
+		t.Errorf("DiscountCode.WaitForBatch got %v codes, expected 3", len(codes))
+	}
+}
+
+func TestDiscountCodeWaitForBatchContextDone(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder(
+		"GET",
+		fmt.Sprintf("https://fooshop.myshopify.com/%s/price_rules/507328175/batch/989355119.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("discount_code_creation.json")),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, _, err := client.DiscountCode.WaitForBatch(ctx, 507328175, 989355119)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("DiscountCode.WaitForBatch returned %v, expected %v", err, context.DeadlineExceeded)
+	}
+}
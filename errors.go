@@ -0,0 +1,114 @@
+package goshopify
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors matching the ResponseError of the corresponding status
+// with errors.Is, e.g.
+//
+//	if errors.Is(err, goshopify.ErrNotFound) {
+//		// the resource was deleted
+//	}
+var (
+	ErrNotFound        = errors.New("shopify: not found")
+	ErrUnauthorized    = errors.New("shopify: invalid API key or access token")
+	ErrForbiddenScope  = errors.New("shopify: access scope missing")
+	ErrPaymentRequired = errors.New("shopify: shop is frozen")
+	ErrLocked          = errors.New("shopify: shop is locked")
+	ErrUnprocessable   = errors.New("shopify: unprocessable entity")
+)
+
+// statusErrors maps the response statuses to their sentinel error
+var statusErrors = map[int]error{
+	http.StatusNotFound:            ErrNotFound,
+	http.StatusUnauthorized:        ErrUnauthorized,
+	http.StatusForbidden:           ErrForbiddenScope,
+	http.StatusPaymentRequired:     ErrPaymentRequired,
+	http.StatusLocked:              ErrLocked,
+	http.StatusUnprocessableEntity: ErrUnprocessable,
+}
+
+// Is reports whether target is the sentinel error of the status
+func (e ResponseError) Is(target error) bool {
+	sentinel, ok := statusErrors[e.Status]
+	return ok && sentinel == target
+}
+
+// ValidationError is returned for 422 responses of Shopify listing errors,
+// it keeps the messages of each invalid field, e.g. {"errors":{"title":["can't be blank"]}}
+// gives Fields["title"] = []string{"can't be blank"}. Errors which are not
+// about a field are under the "base" key like Shopify does.
+type ValidationError struct {
+	ResponseError
+
+	// Fields maps the invalid fields to their messages
+	Fields map[string][]string
+
+	// RequestID is the X-Request-Id of the response, to be given to Shopify
+	// support
+	RequestID string
+}
+
+// Unwrap returns the ResponseError so that errors.As works with it, like for
+// the 422 responses returned as a ResponseError before ValidationError
+func (e ValidationError) Unwrap() error {
+	return e.ResponseError
+}
+
+// FieldErrors returns the messages of a field
+func (e ValidationError) FieldErrors(field string) []string {
+	return e.Fields[field]
+}
+
+// newValidationError returns the ValidationError of a 422 response with the
+// errors decoded from its body
+func newValidationError(r *http.Response, err ResponseError, errs interface{}) ValidationError {
+	fields := make(map[string][]string)
+	switch errs := errs.(type) {
+	case map[string]interface{}:
+		for k, v := range errs {
+			switch v := v.(type) {
+			case []interface{}:
+				for _, elem := range v {
+					fields[k] = append(fields[k], fmt.Sprint(elem))
+				}
+			default:
+				fields[k] = append(fields[k], fmt.Sprint(v))
+			}
+		}
+	case []interface{}:
+		for _, elem := range errs {
+			fields["base"] = append(fields["base"], fmt.Sprint(elem))
+		}
+	case string:
+		if errs != "" {
+			fields["base"] = []string{errs}
+		}
+	}
+
+	return ValidationError{
+		ResponseError: err,
+		Fields:        fields,
+		RequestID:     r.Header.Get("X-Request-Id"),
+	}
+}
+
+// causeError is an error converted to another type which can still be
+// unwrapped to the original error, so that errors.Is and errors.As work with
+// both
+type causeError struct {
+	error
+	cause error
+}
+
+func (e causeError) Unwrap() []error {
+	return []error{e.error, e.cause}
+}
+
+// isError is errors.Is for the files importing the errors of go-helper
+func isError(err, target error) bool {
+	return errors.Is(err, target)
+}
@@ -0,0 +1,160 @@
+package goshopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cases := []struct {
+		status   int
+		body     string
+		expected error
+	}{
+		{404, `{"errors":"Not Found"}`, ErrNotFound},
+		{401, `{"errors":"[API] Invalid API key or access token (unrecognized login or wrong password)"}`, ErrUnauthorized},
+		{403, `{"errors":"This action requires merchant approval for read_products scope."}`, ErrForbiddenScope},
+		{402, `{"errors":"Unavailable Shop"}`, ErrPaymentRequired},
+		{423, `{"errors":"This shop is unavailable"}`, ErrLocked},
+		{422, `{"errors":{"title":["can't be blank"]}}`, ErrUnprocessable},
+	}
+
+	url := fmt.Sprintf("https://fooshop.myshopify.com/%s/products/1.json", client.pathPrefix)
+	for _, c := range cases {
+		httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(c.status, c.body))
+
+		_, err := client.Product.Get(context.Background(), 1, nil)
+		if !errors.Is(err, c.expected) {
+			t.Errorf("Product.Get with status %d returned %v, expected %v", c.status, err, c.expected)
+		}
+		for _, other := range cases {
+			if other.expected != c.expected && errors.Is(err, other.expected) {
+				t.Errorf("Product.Get with status %d returned an error matching %v", c.status, other.expected)
+			}
+		}
+
+		var respErr ResponseError
+		if !errors.As(err, &respErr) || respErr.Status != c.status {
+			t.Errorf("Product.Get with status %d returned %v, expected a ResponseError", c.status, err)
+		}
+	}
+}
+
+func TestSentinelErrorsConverted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	url := fmt.Sprintf("https://fooshop.myshopify.com/%s/products/1.json", client.pathPrefix)
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(401,
+		`{"errors":"[API] Invalid API key or access token (unrecognized login or wrong password)"}`))
+
+	_, err := client.Product.Get(context.Background(), 1, nil)
+	expectedMessage := "Shopify responded: [API] Invalid API key or access token (unrecognized login or wrong password)"
+	if err == nil || err.Error() != expectedMessage {
+		t.Errorf("Product.Get returned %v, expected %q", err, expectedMessage)
+	}
+	if !IsInvalidTokenError(err) {
+		t.Errorf("IsInvalidTokenError(%v) = false, expected true", err)
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/products.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(422,
+				`{"errors":{"title":["can't be blank"],"handle":["is too long","is invalid"],"base":"Product is locked"}}`)
+			resp.Header.Set("X-Request-Id", "abc-123")
+			return resp, nil
+		})
+
+	_, err := client.Product.Create(context.Background(), Product{})
+
+	var validationErr ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Product.Create returned %v, expected a ValidationError", err)
+	}
+	expectedFields := map[string][]string{
+		"title":  {"can't be blank"},
+		"handle": {"is too long", "is invalid"},
+		"base":   {"Product is locked"},
+	}
+	if !reflect.DeepEqual(validationErr.Fields, expectedFields) {
+		t.Errorf("ValidationError.Fields = %v, expected %v", validationErr.Fields, expectedFields)
+	}
+	if validationErr.RequestID != "abc-123" {
+		t.Errorf("ValidationError.RequestID = %q, expected abc-123", validationErr.RequestID)
+	}
+	if msgs := validationErr.FieldErrors("title"); !reflect.DeepEqual(msgs, []string{"can't be blank"}) {
+		t.Errorf("ValidationError.FieldErrors(title) = %v, expected [can't be blank]", msgs)
+	}
+	if len(validationErr.Errors) != 4 {
+		t.Errorf("ValidationError.Errors = %v, expected the flattened field errors", validationErr.Errors)
+	}
+}
+
+func TestValidationErrorList(t *testing.T) {
+	cases := []struct {
+		body     string
+		expected map[string][]string
+	}{
+		{`{"errors":["Title is missing","Price is invalid"]}`, map[string][]string{"base": {"Title is missing", "Price is invalid"}}},
+		{`{"errors":"Required parameter missing"}`, map[string][]string{"base": {"Required parameter missing"}}},
+	}
+
+	for _, c := range cases {
+		err := CheckResponseError(httpmock.NewStringResponse(422, c.body))
+		validationErr, ok := err.(ValidationError)
+		if !ok {
+			t.Errorf("CheckResponseError(%s) returned %#v, expected a ValidationError", c.body, err)
+			continue
+		}
+		if !reflect.DeepEqual(validationErr.Fields, c.expected) {
+			t.Errorf("CheckResponseError(%s) fields = %v, expected %v", c.body, validationErr.Fields, c.expected)
+		}
+	}
+}
+
+func TestValidationErrorIsResponseError(t *testing.T) {
+	// a 422 without field errors is the ResponseError it always was
+	for _, body := range []string{`{"error":"Cannot delete the default location"}`, ``} {
+		err := CheckResponseError(httpmock.NewStringResponse(422, body))
+		respErr, ok := err.(ResponseError)
+		if !ok || respErr.Status != 422 {
+			t.Errorf("CheckResponseError(%s) returned %#v, expected a ResponseError", body, err)
+		}
+		if !errors.Is(err, ErrUnprocessable) {
+			t.Errorf("CheckResponseError(%s) returned %v, expected it to match ErrUnprocessable", body, err)
+		}
+	}
+
+	err := CheckResponseError(httpmock.NewStringResponse(422, `{"errors":{"title":["can't be blank"]}}`))
+	var respErr ResponseError
+	if !errors.As(err, &respErr) || respErr.Status != 422 || respErr.Message != "title: can't be blank" {
+		t.Errorf("errors.As(%v, ResponseError) = %#v, expected the 422 ResponseError", err, respErr)
+	}
+	if target := &(ResponseError{}); !errors.As(err, target) || target.Status != 422 {
+		t.Errorf("errors.As(%v, &ResponseError{}) = %#v, expected the 422 ResponseError", err, target)
+	}
+
+	// and through the conversions of the services
+	setup()
+	defer teardown()
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/products.json", client.pathPrefix),
+		httpmock.NewStringResponder(422, `{"errors":{"title":["can't be blank"]}}`))
+	_, err = client.Product.Create(context.Background(), Product{})
+	if !errors.As(err, &respErr) || respErr.Status != 422 {
+		t.Errorf("Product.Create returned %v, expected a 422 ResponseError", err)
+	}
+}
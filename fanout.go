@@ -0,0 +1,321 @@
+package goshopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultFanOutConcurrency is the number of shops FanOut runs at once
+	defaultFanOutConcurrency = 10
+
+	// defaultFanOutRate is the request rate budget of a shop, the leak rate
+	// of the REST api bucket
+	defaultFanOutRate = 2
+)
+
+// FanOutShop is a shop FanOut runs the operation on
+type FanOutShop struct {
+	// Name is the myshopify domain of the shop, e.g. "theshop.myshopify.com",
+	// or simply "theshop"
+	Name  string
+	Token string
+}
+
+// FanOutFunc is the operation FanOut runs with the client of each shop
+type FanOutFunc func(ctx context.Context, client *Client) error
+
+// FanOutOptions configures FanOut
+type FanOutOptions struct {
+	// App and ClientOptions are used to create the client of each shop
+	App           App
+	ClientOptions []Option
+
+	// Concurrency is the number of shops run at once, defaults to 10
+	Concurrency int
+
+	// RequestsPerSecond is the request rate budget of each shop, defaults
+	// to 2
+	RequestsPerSecond float64
+
+	// Checkpoint of a previous run, the shops it completed are skipped.
+	// It is updated with the shops completed by this run.
+	Checkpoint *FanOutCheckpoint
+
+	// OnResult is called after each shop, e.g. to save the checkpoint. The
+	// calls are not concurrent.
+	OnResult func(result FanOutResult, checkpoint *FanOutCheckpoint)
+}
+
+// FanOutFailure classifies the error of a shop
+type FanOutFailure string
+
+const (
+	FailureNone         FanOutFailure = ""
+	FailureInvalidShop  FanOutFailure = "invalid_shop"
+	FailureInvalidToken FanOutFailure = "invalid_token"
+	FailureMissingScope FanOutFailure = "missing_scope"
+	FailureFrozen       FanOutFailure = "frozen"
+	FailureLocked       FanOutFailure = "locked"
+	FailureNotFound     FanOutFailure = "not_found"
+	FailureRateLimited  FanOutFailure = "rate_limited"
+	FailureUnavailable  FanOutFailure = "unavailable"
+	FailureCircuitOpen  FanOutFailure = "circuit_open"
+	FailureCanceled     FanOutFailure = "canceled"
+	FailureOther        FanOutFailure = "other"
+)
+
+// ClassifyFailure returns the FanOutFailure of an error returned by a client
+func ClassifyFailure(err error) FanOutFailure {
+	var rateLimitErr RateLimitError
+	var respErr ResponseError
+	var shopErr fanOutShopError
+	switch {
+	case err == nil:
+		return FailureNone
+	case errors.As(err, &shopErr):
+		return FailureInvalidShop
+	case IsInvalidTokenError(err):
+		return FailureInvalidToken
+	case errors.Is(err, ErrForbiddenScope):
+		return FailureMissingScope
+	case errors.Is(err, ErrCircuitOpen):
+		return FailureCircuitOpen
+	case errors.Is(err, ErrPaymentRequired):
+		return FailureFrozen
+	case errors.Is(err, ErrLocked):
+		return FailureLocked
+	case errors.Is(err, ErrNotFound):
+		return FailureNotFound
+	case errors.As(err, &rateLimitErr):
+		return FailureRateLimited
+	case errors.As(err, &respErr) && respErr.Status >= http.StatusInternalServerError:
+		return FailureUnavailable
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return FailureCanceled
+	}
+	return FailureOther
+}
+
+// FanOutResult is the outcome of the operation on a shop
+type FanOutResult struct {
+	Shop     string
+	Err      error
+	Failure  FanOutFailure
+	Duration time.Duration
+}
+
+// FanOutCheckpoint records the shops completed by FanOut to resume an
+// interrupted run, it can be stored as JSON
+type FanOutCheckpoint struct {
+	Completed map[string]bool `json:"completed"`
+}
+
+// Done reports whether a shop was completed
+func (c *FanOutCheckpoint) Done(shop string) bool {
+	return c.Completed[fanOutKey(shop)]
+}
+
+func (c *FanOutCheckpoint) complete(shop string) {
+	if c.Completed == nil {
+		c.Completed = make(map[string]bool)
+	}
+	c.Completed[fanOutKey(shop)] = true
+}
+
+// FanOutReport aggregates the results of FanOut
+type FanOutReport struct {
+	// Results are the results of the shops run, in the order of the shops
+	Results []FanOutResult
+
+	Succeeded int
+	Failed    int
+
+	// Skipped is the number of shops completed by the checkpoint
+	Skipped int
+
+	// Failures counts the failed shops by failure
+	Failures map[FanOutFailure]int
+
+	Checkpoint *FanOutCheckpoint
+}
+
+// Errors returns the results of the failed shops
+func (r *FanOutReport) Errors() []FanOutResult {
+	var failed []FanOutResult
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// FanOut runs an operation on many shops with a client for each, e.g.
+//
+//	report, err := goshopify.FanOut(ctx, shops, func(ctx context.Context, c *goshopify.Client) error {
+//		_, err := c.ScriptTag.Update(ctx, tag)
+//		return err
+//	}, goshopify.FanOutOptions{App: app})
+//
+// At most Concurrency shops are run at once and the requests to each shop are
+// limited to RequestsPerSecond. The failure of a shop does not stop the other
+// shops, the errors are classified in the report. The returned error is the
+// error of ctx if it is done before all shops are run, the shops not run are
+// not in the checkpoint so that FanOut can be resumed with it.
+func FanOut(ctx context.Context, shops []FanOutShop, fn FanOutFunc, opts FanOutOptions) (*FanOutReport, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultFanOutConcurrency
+	}
+	rate := opts.RequestsPerSecond
+	if rate <= 0 {
+		rate = defaultFanOutRate
+	}
+	checkpoint := opts.Checkpoint
+	if checkpoint == nil {
+		checkpoint = &FanOutCheckpoint{}
+	}
+
+	report := &FanOutReport{
+		Failures:   make(map[FanOutFailure]int),
+		Checkpoint: checkpoint,
+	}
+	results := make([]*FanOutResult, len(shops))
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+
+run:
+	for i, shop := range shops {
+		mu.Lock()
+		done := checkpoint.Done(shop.Name)
+		mu.Unlock()
+		if done {
+			report.Skipped++
+			continue
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			break run
+		case slots <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int, shop FanOutShop) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			start := time.Now()
+			err := fanOutShop(ctx, shop, fn, opts, rate)
+			result := FanOutResult{
+				Shop:     shop.Name,
+				Err:      err,
+				Failure:  ClassifyFailure(err),
+				Duration: time.Since(start),
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			results[i] = &result
+			if err == nil {
+				checkpoint.complete(shop.Name)
+			}
+			if opts.OnResult != nil {
+				opts.OnResult(result, checkpoint)
+			}
+		}(i, shop)
+	}
+	wg.Wait()
+
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		report.Results = append(report.Results, *result)
+		if result.Err == nil {
+			report.Succeeded++
+		} else {
+			report.Failed++
+			report.Failures[result.Failure]++
+		}
+	}
+	return report, ctx.Err()
+}
+
+// fanOutShop runs the operation on a shop with a rate limited client
+func fanOutShop(ctx context.Context, shop FanOutShop, fn FanOutFunc, opts FanOutOptions, rate float64) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	clientOpts := append([]Option{}, opts.ClientOptions...)
+	clientOpts = append(clientOpts, WithMiddleware(rateBudgetMiddleware(rate)))
+	client, err := NewClientE(opts.App, shop.Name, shop.Token, clientOpts...)
+	if err != nil {
+		return fanOutShopError{err}
+	}
+	return fn(ctx, client)
+}
+
+// fanOutShopError is the error of a shop which is not a valid myshopify
+// domain
+type fanOutShopError struct {
+	error
+}
+
+func (e fanOutShopError) Unwrap() error {
+	return e.error
+}
+
+// fanOutKey returns the key of a shop in a checkpoint
+func fanOutKey(shop string) string {
+	if domain, err := ValidShopDomain(shop); err == nil {
+		return domain
+	}
+	return shop
+}
+
+// rateBudgetMiddleware spaces the requests of a client to send at most rate
+// requests per second
+func rateBudgetMiddleware(rate float64) Middleware {
+	interval := time.Duration(float64(time.Second) / rate)
+	var mu sync.Mutex
+	var next time.Time
+
+	return func(handler RequestHandler) RequestHandler {
+		return func(req *http.Request, info RequestInfo) (*http.Response, error) {
+			mu.Lock()
+			now := time.Now()
+			if next.Before(now) {
+				next = now
+			}
+			wait := next.Sub(now)
+			next = next.Add(interval)
+			mu.Unlock()
+
+			if wait > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-req.Context().Done():
+					timer.Stop()
+					return nil, req.Context().Err()
+				case <-timer.C:
+				}
+			}
+			return handler(req, info)
+		}
+	}
+}
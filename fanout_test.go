@@ -0,0 +1,174 @@
+package goshopify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+// fanOutTransport answers the shop.json of the shops with the given status
+func fanOutTransport(statuses map[string]int) *httpmock.MockTransport {
+	transport := httpmock.NewMockTransport()
+	for shop, status := range statuses {
+		body := `{"shop":{"id":1}}`
+		switch status {
+		case 401:
+			body = `{"errors":"[API] Invalid API key or access token (unrecognized login or wrong password)"}`
+		case 402:
+			body = `{"errors":"Unavailable Shop"}`
+		case 429:
+			body = `{"errors":"Exceeded 2 calls per second for api client."}`
+		}
+		transport.RegisterResponder("GET", "https://"+shop+".myshopify.com/admin/shop.json",
+			httpmock.NewStringResponder(status, body))
+	}
+	return transport
+}
+
+func TestFanOut(t *testing.T) {
+	transport := fanOutTransport(map[string]int{
+		"ok1": 200, "ok2": 200, "ok3": 200, "token": 401, "frozen": 402, "throttled": 429,
+	})
+	shops := []FanOutShop{
+		{Name: "ok1"}, {Name: "token"}, {Name: "ok2"}, {Name: "frozen"},
+		{Name: "throttled"}, {Name: "ok3"}, {Name: "evil.com"},
+	}
+
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	fn := func(ctx context.Context, c *Client) error {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+
+		time.Sleep(5 * time.Millisecond)
+		_, err := c.Shop.Get(ctx, nil)
+		return err
+	}
+
+	var onResult []string
+	opts := FanOutOptions{
+		ClientOptions: []Option{WithHTTPClient(&http.Client{Transport: transport})},
+		Concurrency:   2,
+		OnResult: func(result FanOutResult, checkpoint *FanOutCheckpoint) {
+			onResult = append(onResult, result.Shop)
+		},
+	}
+	report, err := FanOut(context.Background(), shops, fn, opts)
+	if err != nil {
+		t.Fatalf("FanOut returned error: %v", err)
+	}
+
+	if maxRunning > 2 {
+		t.Errorf("FanOut ran %d shops at once, expected at most 2", maxRunning)
+	}
+	if len(onResult) != len(shops) || len(report.Results) != len(shops) {
+		t.Errorf("FanOut reported %d results and called OnResult %d times, expected %d", len(report.Results), len(onResult), len(shops))
+	}
+	if report.Succeeded != 3 || report.Failed != 4 || report.Skipped != 0 {
+		t.Errorf("FanOut report = %+v, expected 3 succeeded and 4 failed", report)
+	}
+	expectedFailures := map[string]FanOutFailure{
+		"ok1": FailureNone, "token": FailureInvalidToken, "ok2": FailureNone, "frozen": FailureFrozen,
+		"throttled": FailureRateLimited, "ok3": FailureNone, "evil.com": FailureInvalidShop,
+	}
+	for i, result := range report.Results {
+		if result.Shop != shops[i].Name || result.Failure != expectedFailures[result.Shop] {
+			t.Errorf("FanOut result %d = %+v, expected shop %s with failure %q", i, result, shops[i].Name, expectedFailures[shops[i].Name])
+		}
+	}
+	if len(report.Errors()) != 4 {
+		t.Errorf("FanOutReport.Errors() = %v, expected 4 errors", report.Errors())
+	}
+
+	// resuming runs the failed shops only
+	var resumed []string
+	opts.Checkpoint = report.Checkpoint
+	opts.OnResult = nil
+	report, err = FanOut(context.Background(), shops, func(ctx context.Context, c *Client) error {
+		mu.Lock()
+		resumed = append(resumed, c.shop)
+		mu.Unlock()
+		return nil
+	}, opts)
+	if err != nil {
+		t.Fatalf("FanOut returned error: %v", err)
+	}
+	if report.Skipped != 3 || report.Succeeded != 3 || report.Failed != 1 || len(resumed) != 3 {
+		t.Errorf("resumed FanOut report = %+v, ran %v, expected the failed shops to run", report, resumed)
+	}
+	if !report.Checkpoint.Done("ok1") || !report.Checkpoint.Done("frozen.myshopify.com") || report.Checkpoint.Done("evil.com") {
+		t.Errorf("FanOut checkpoint = %v, expected the succeeded shops", report.Checkpoint.Completed)
+	}
+}
+
+func TestFanOutCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	shops := []FanOutShop{{Name: "shop1"}, {Name: "shop2"}, {Name: "shop3"}}
+
+	report, err := FanOut(ctx, shops, func(ctx context.Context, c *Client) error {
+		cancel()
+		return nil
+	}, FanOutOptions{Concurrency: 1})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("FanOut returned %v, expected the context error", err)
+	}
+	if len(report.Results) != 1 || !report.Checkpoint.Done("shop1") || report.Checkpoint.Done("shop2") {
+		t.Errorf("FanOut report = %+v, expected the first shop only", report)
+	}
+}
+
+func TestFanOutRateBudget(t *testing.T) {
+	transport := fanOutTransport(map[string]int{"fooshop": 200})
+	start := time.Now()
+	report, err := FanOut(context.Background(), []FanOutShop{{Name: "fooshop"}}, func(ctx context.Context, c *Client) error {
+		for i := 0; i < 3; i++ {
+			if _, err := c.Shop.Get(ctx, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, FanOutOptions{
+		ClientOptions:     []Option{WithHTTPClient(&http.Client{Transport: transport})},
+		RequestsPerSecond: 50,
+	})
+	if err != nil || report.Succeeded != 1 {
+		t.Fatalf("FanOut returned %+v, %v, expected the shop to succeed", report, err)
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("FanOut sent 3 requests in %s, expected at least 40ms at 50 requests per second", elapsed)
+	}
+}
+
+func TestClassifyFailure(t *testing.T) {
+	cases := []struct {
+		err      error
+		expected FanOutFailure
+	}{
+		{nil, FailureNone},
+		{ResponseError{Status: 403}, FailureMissingScope},
+		{ResponseError{Status: 404}, FailureNotFound},
+		{ResponseError{Status: 423}, FailureLocked},
+		{ResponseError{Status: 502}, FailureUnavailable},
+		{context.DeadlineExceeded, FailureCanceled},
+		{errors.New("boom"), FailureOther},
+	}
+	for _, c := range cases {
+		if actual := ClassifyFailure(c.err); actual != c.expected {
+			t.Errorf("ClassifyFailure(%v) = %q, expected %q", c.err, actual, c.expected)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package goshopify
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Fields returns the value of a fields option selecting fields of a resource
+// type, e.g. Fields[Product]("id", "title", "variants") returns
+// "id,title,variants". The names are the json keys of the resource and an
+// error is returned for unknown ones, which Shopify would silently omit.
+func Fields[T any](names ...string) (string, error) {
+	known := jsonFields(reflect.TypeOf((*T)(nil)).Elem())
+	var errs []error
+	var fields []string
+	seen := make(map[string]bool)
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if _, ok := known[name]; !ok {
+			errs = append(errs, fmt.Errorf("fields %s: unknown field %q", resourceTypeName[T](), name))
+			continue
+		}
+		if !seen[name] {
+			seen[name] = true
+			fields = append(fields, name)
+		}
+	}
+	if len(errs) > 0 {
+		return "", errors.Join(errs...)
+	}
+	if len(fields) == 0 {
+		return "", fmt.Errorf("fields %s: no fields selected", resourceTypeName[T]())
+	}
+	return strings.Join(fields, ","), nil
+}
+
+// MustFields is like Fields but panics if a field is unknown, it simplifies
+// the initialization of the field lists known at compile time
+//
+//	var productSummaryFields = goshopify.MustFields[goshopify.Product]("id", "title", "handle")
+func MustFields[T any](names ...string) string {
+	fields, err := Fields[T](names...)
+	if err != nil {
+		panic(err)
+	}
+	return fields
+}
+
+// ProjectionFields returns the value of a fields option selecting the fields
+// of a resource type T used by a projection struct P, i.e. the json keys of
+// P which must all be fields of T. Only these fields are then downloaded.
+//
+//	type productSummary struct {
+//		ID    int64  `json:"id"`
+//		Title string `json:"title"`
+//	}
+//	fields, err := goshopify.ProjectionFields[goshopify.Product, productSummary]()
+func ProjectionFields[T, P any]() (string, error) {
+	t := reflect.TypeOf((*P)(nil)).Elem()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return "", fmt.Errorf("fields %s: projection %s is not a struct", resourceTypeName[T](), t)
+	}
+
+	projected := jsonFields(t)
+	names := make([]string, 0, len(projected))
+	for name := range projected {
+		names = append(names, name)
+	}
+	// keep the order of the projection struct
+	sort.Slice(names, func(i, j int) bool {
+		return indexLess(projected[names[i]].Index, projected[names[j]].Index)
+	})
+	return Fields[T](names...)
+}
+
+// MustProjectionFields is like ProjectionFields but panics on errors
+func MustProjectionFields[T, P any]() string {
+	fields, err := ProjectionFields[T, P]()
+	if err != nil {
+		panic(err)
+	}
+	return fields
+}
+
+// indexLess reports whether the struct field index a comes before b
+func indexLess(a, b []int) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
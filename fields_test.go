@@ -0,0 +1,88 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestFields(t *testing.T) {
+	cases := []struct {
+		names    []string
+		expected string
+		err      string
+	}{
+		{[]string{"id", "title", "variants"}, "id,title,variants", ""},
+		{[]string{"id", " handle ", "id"}, "id,handle", ""},
+		{[]string{"id", "titel", "varaints"}, "", "fields Product: unknown field \"titel\"\nfields Product: unknown field \"varaints\""},
+		{nil, "", "fields Product: no fields selected"},
+	}
+
+	for _, c := range cases {
+		fields, err := Fields[Product](c.names...)
+		errMessage := ""
+		if err != nil {
+			errMessage = err.Error()
+		}
+		if fields != c.expected || errMessage != c.err {
+			t.Errorf("Fields[Product](%v) = %q, %v, expected %q, %q", c.names, fields, err, c.expected, c.err)
+		}
+	}
+}
+
+func TestMustFields(t *testing.T) {
+	if fields := MustFields[Variant]("id", "sku"); fields != "id,sku" {
+		t.Errorf("MustFields[Variant] = %q, expected id,sku", fields)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("MustFields[Variant] with an unknown field did not panic")
+		}
+	}()
+	MustFields[Variant]("skus")
+}
+
+type productSummary struct {
+	ID       int64     `json:"id"`
+	Title    string    `json:"title"`
+	Variants []Variant `json:"variants"`
+	internal string
+	Ignored  string `json:"-"`
+}
+
+type productSummaryTypo struct {
+	ID    int64  `json:"id"`
+	Title string `json:"name"`
+}
+
+func TestProjectionFields(t *testing.T) {
+	setup()
+	defer teardown()
+
+	fields, err := ProjectionFields[Product, productSummary]()
+	if err != nil || fields != "id,title,variants" {
+		t.Fatalf("ProjectionFields[Product, productSummary] = %q, %v, expected id,title,variants", fields, err)
+	}
+
+	httpmock.RegisterResponderWithQuery("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products.json", client.pathPrefix),
+		map[string]string{"fields": "id,title,variants"},
+		httpmock.NewStringResponder(http.StatusOK, `{"products":[{"id":1,"title":"foo"}]}`))
+
+	products, err := client.Product.List(context.Background(), ListOptions{Fields: fields})
+	if err != nil || len(products) != 1 {
+		t.Errorf("Product.List returned %v, %v, expected the product", products, err)
+	}
+
+	_, err = ProjectionFields[Product, productSummaryTypo]()
+	if err == nil || err.Error() != `fields Product: unknown field "name"` {
+		t.Errorf("ProjectionFields[Product, productSummaryTypo] returned %v, expected an unknown field error", err)
+	}
+	_, err = ProjectionFields[Product, string]()
+	if err == nil || err.Error() != "fields Product: projection string is not a struct" {
+		t.Errorf("ProjectionFields[Product, string] returned %v, expected a projection error", err)
+	}
+}
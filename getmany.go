@@ -0,0 +1,271 @@
+package goshopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// getManyChunkSize is the number of ids requested at once, the maximum
+	// limit of the list endpoints
+	getManyChunkSize = 250
+
+	// getManyConcurrency is the number of chunks requested at once
+	getManyConcurrency = 4
+
+	// defaultLoaderWait is how long a Loader collects ids before requesting
+	// them
+	defaultLoaderWait = 2 * time.Millisecond
+)
+
+// GetManyResult is the result of a GetMany call
+type GetManyResult[T any] struct {
+	// Found are the resources found by id
+	Found map[int64]*T
+
+	// Missing are the sorted ids which were not found, the ids of the
+	// requests which failed are not missing
+	Missing []int64
+}
+
+// GetManyFunc is the GetMany method of a service, see NewLoader
+type GetManyFunc[T any] func(ctx context.Context, ids []int64) (*GetManyResult[T], error)
+
+// getManyOptions are the list options of a chunk of ids, status is needed by
+// orders which are filtered on open ones otherwise
+type getManyOptions struct {
+	IDs    []int64 `url:"ids,comma"`
+	Limit  int     `url:"limit"`
+	Status string  `url:"status,omitempty"`
+}
+
+// getMany gets resources by id from a list endpoint, in chunks of chunkSize
+// ids requested concurrently. The pages of each chunk are followed. No chunk
+// is requested once ctx is done, the ids of the chunks which failed or were
+// not requested are not missing.
+func getMany[T any](ctx context.Context, c *Client, path, key string, ids []int64, chunkSize int, status string, idOf func(T) int64) (*GetManyResult[T], error) {
+	ids = uniqueIDs(ids)
+	result := &GetManyResult[T]{Found: make(map[int64]*T, len(ids))}
+
+	var mu sync.Mutex
+	var errs []error
+	var requested []int64
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, getManyConcurrency)
+	for start := 0; start < len(ids); start += chunkSize {
+		if !acquireSlot(ctx, slots) {
+			break
+		}
+		chunk := ids[start:min(start+chunkSize, len(ids))]
+		wg.Add(1)
+		go func(chunk []int64) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			options := getManyOptions{IDs: chunk, Limit: chunkSize, Status: status}
+			err := streamList(ctx, c, path, key, options, func(resource T) error {
+				mu.Lock()
+				result.Found[idOf(resource)] = &resource
+				mu.Unlock()
+				return nil
+			})
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				requested = append(requested, chunk...)
+			case ctx.Err() == nil:
+				errs = append(errs, err)
+			}
+		}(chunk)
+	}
+	wg.Wait()
+
+	result.Missing = missingIDs(uniqueIDs(requested), result.Found)
+	return result, getManyError(ctx, errs)
+}
+
+// getEach gets resources by id one at a time with getManyConcurrency
+// requests at once, for the resources which can't be listed by ids. The ids
+// not found are missing. No id is requested once ctx is done.
+func getEach[T any](ctx context.Context, ids []int64, get func(context.Context, int64) (*T, error)) (*GetManyResult[T], error) {
+	ids = uniqueIDs(ids)
+	result := &GetManyResult[T]{Found: make(map[int64]*T, len(ids))}
+
+	var mu sync.Mutex
+	var errs []error
+	var missing []int64
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, getManyConcurrency)
+	for _, id := range ids {
+		if !acquireSlot(ctx, slots) {
+			break
+		}
+		wg.Add(1)
+		go func(id int64) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			resource, err := get(ctx, id)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case errors.Is(err, ErrNotFound), err == nil && resource == nil:
+				missing = append(missing, id)
+			case err == nil:
+				result.Found[id] = resource
+			case ctx.Err() == nil:
+				errs = append(errs, err)
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	result.Missing = uniqueIDs(missing)
+	return result, getManyError(ctx, errs)
+}
+
+// acquireSlot waits for a free slot, it returns false once ctx is done
+func acquireSlot(ctx context.Context, slots chan struct{}) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	select {
+	case slots <- struct{}{}:
+	case <-ctx.Done():
+		return false
+	}
+	if ctx.Err() != nil {
+		<-slots
+		return false
+	}
+	return true
+}
+
+// getManyError joins the errors of the requests, the error of ctx is
+// returned once rather than by request
+func getManyError(ctx context.Context, errs []error) error {
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// uniqueIDs returns the sorted ids without duplicates
+func uniqueIDs(ids []int64) []int64 {
+	unique := append([]int64(nil), ids...)
+	sort.Slice(unique, func(i, j int) bool { return unique[i] < unique[j] })
+	n := 0
+	for i, id := range unique {
+		if i == 0 || id != unique[n-1] {
+			unique[n] = id
+			n++
+		}
+	}
+	return unique[:n]
+}
+
+func missingIDs[T any](ids []int64, found map[int64]*T) []int64 {
+	var missing []int64
+	for _, id := range ids {
+		if _, ok := found[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
+
+// Loader coalesces the concurrent gets of single resources into GetMany
+// calls, like a DataLoader. The ids requested within the wait of the first
+// one are got at once.
+//
+//	loader := goshopify.NewLoader(client.Product.GetMany, 0)
+//	product, err := loader.Load(ctx, productID)
+type Loader[T any] struct {
+	getMany GetManyFunc[T]
+	wait    time.Duration
+
+	mu    sync.Mutex
+	batch *loaderBatch[T]
+}
+
+type loaderBatch[T any] struct {
+	ids     map[int64]bool
+	started bool
+	done    chan struct{}
+	result  *GetManyResult[T]
+	err     error
+}
+
+// NewLoader returns a Loader of a GetMany method, collecting ids for wait
+// before getting them, 2ms if wait is 0
+func NewLoader[T any](getMany GetManyFunc[T], wait time.Duration) *Loader[T] {
+	if wait <= 0 {
+		wait = defaultLoaderWait
+	}
+	return &Loader[T]{getMany: getMany, wait: wait}
+}
+
+// Load returns the resource of an id, or an error matching ErrNotFound if it
+// does not exist
+func (l *Loader[T]) Load(ctx context.Context, id int64) (*T, error) {
+	l.mu.Lock()
+	batch := l.batch
+	if batch == nil {
+		batch = &loaderBatch[T]{ids: make(map[int64]bool), done: make(chan struct{})}
+		l.batch = batch
+		// the batch is not canceled with the context of the first caller
+		time.AfterFunc(l.wait, func() { l.run(context.WithoutCancel(ctx), batch) })
+	}
+	batch.ids[id] = true
+	if len(batch.ids) >= getManyChunkSize {
+		l.batch = nil
+		go l.run(context.WithoutCancel(ctx), batch)
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-batch.done:
+	}
+	if resource, ok := batch.result.Found[id]; ok {
+		return resource, nil
+	}
+	if batch.err != nil {
+		return nil, batch.err
+	}
+	return nil, fmt.Errorf("%s %d: %w", resourceTypeName[T](), id, ErrNotFound)
+}
+
+// run gets the ids of a batch, once
+func (l *Loader[T]) run(ctx context.Context, batch *loaderBatch[T]) {
+	l.mu.Lock()
+	if l.batch == batch {
+		l.batch = nil
+	}
+	if batch.started {
+		// already run when full
+		l.mu.Unlock()
+		return
+	}
+	batch.started = true
+	ids := make([]int64, 0, len(batch.ids))
+	for id := range batch.ids {
+		ids = append(ids, id)
+	}
+	l.mu.Unlock()
+
+	result, err := l.getMany(ctx, ids)
+	if result == nil {
+		result = &GetManyResult[T]{}
+	}
+	batch.result, batch.err = result, err
+	close(batch.done)
+}
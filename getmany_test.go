@@ -0,0 +1,263 @@
+package goshopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+// registerListByIDs registers a list endpoint returning the requested even
+// ids, 100 per page
+func registerListByIDs(t *testing.T, path, key string, expectedQuery map[string]string) *[]string {
+	var mu sync.Mutex
+	var requested []string
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/%s", client.pathPrefix, path),
+		func(req *http.Request) (*http.Response, error) {
+			query := req.URL.Query()
+			for k, v := range expectedQuery {
+				if query.Get(k) != v {
+					t.Errorf("GET %s %s = %q, expected %q", path, k, query.Get(k), v)
+				}
+			}
+
+			ids := query.Get("ids")
+			if pageInfo := query.Get("page_info"); pageInfo != "" {
+				ids = strings.ReplaceAll(pageInfo, "-", ",")
+			}
+			mu.Lock()
+			requested = append(requested, ids)
+			mu.Unlock()
+
+			var found, next []string
+			for _, id := range strings.Split(ids, ",") {
+				if len(found) == 100 {
+					// the rest of the ids are on the next page
+					next = append(next, id)
+				} else if n, _ := strconv.Atoi(id); n%2 == 0 {
+					found = append(found, fmt.Sprintf(`{"id":%s}`, id))
+				}
+			}
+			resp := httpmock.NewStringResponse(200, fmt.Sprintf(`{"%s":[%s]}`, key, strings.Join(found, ",")))
+			if len(next) > 0 {
+				resp.Header.Set("Link", fmt.Sprintf(`<https://fooshop.myshopify.com/%s/%s?page_info=%s>; rel="next"`,
+					client.pathPrefix, path, strings.Join(next, "-")))
+			}
+			return resp, nil
+		})
+	return &requested
+}
+
+func TestProductGetMany(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requested := registerListByIDs(t, "products.json", "products", nil)
+
+	var ids []int64
+	for id := int64(300); id >= 1; id-- {
+		ids = append(ids, id)
+	}
+	ids = append(ids, 2)
+
+	result, err := client.Product.GetMany(context.Background(), ids)
+	if err != nil {
+		t.Fatalf("Product.GetMany returned error: %v", err)
+	}
+	if len(result.Found) != 150 || len(result.Missing) != 150 {
+		t.Errorf("Product.GetMany found %d and missed %d, expected 150 each", len(result.Found), len(result.Missing))
+	}
+	if p := result.Found[300]; p == nil || p.ID != 300 {
+		t.Errorf("Product.GetMany found %v for 300, expected the product", p)
+	}
+	if result.Missing[0] != 1 || result.Missing[149] != 299 {
+		t.Errorf("Product.GetMany missing = %v, expected the odd ids", result.Missing)
+	}
+	// 2 chunks of 250 and 50 ids, the first one has 2 pages
+	if len(*requested) != 3 {
+		t.Errorf("Product.GetMany sent %d requests, expected 3", len(*requested))
+	}
+}
+
+func TestGetManyServices(t *testing.T) {
+	setup()
+	defer teardown()
+	ctx := context.Background()
+	ids := []int64{1, 2}
+
+	registerListByIDs(t, "orders.json", "orders", map[string]string{"status": "any", "ids": "1,2", "limit": "250"})
+	if result, err := client.Order.GetMany(ctx, ids); err != nil || result.Found[2] == nil || !reflect.DeepEqual(result.Missing, []int64{1}) {
+		t.Errorf("Order.GetMany returned %+v, %v", result, err)
+	}
+
+	registerListByIDs(t, "customers.json", "customers", nil)
+	if result, err := client.Customer.GetMany(ctx, ids); err != nil || result.Found[2] == nil {
+		t.Errorf("Customer.GetMany returned %+v, %v", result, err)
+	}
+
+	registerListByIDs(t, "custom_collections.json", "custom_collections", nil)
+	if result, err := client.CustomCollection.GetMany(ctx, ids); err != nil || result.Found[2] == nil {
+		t.Errorf("CustomCollection.GetMany returned %+v, %v", result, err)
+	}
+
+	registerListByIDs(t, "smart_collections.json", "smart_collections", nil)
+	if result, err := client.SmartCollection.GetMany(ctx, ids); err != nil || result.Found[2] == nil {
+		t.Errorf("SmartCollection.GetMany returned %+v, %v", result, err)
+	}
+
+	registerListByIDs(t, "inventory_items.json", "inventory_items", map[string]string{"limit": "100"})
+	if result, err := client.InventoryItem.GetMany(ctx, ids); err != nil || result.Found[2] == nil {
+		t.Errorf("InventoryItem.GetMany returned %+v, %v", result, err)
+	}
+}
+
+func TestVariantGetMany(t *testing.T) {
+	setup()
+	defer teardown()
+
+	for _, id := range []int64{1, 2} {
+		httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/variants/%d.json", client.pathPrefix, id),
+			httpmock.NewStringResponder(200, fmt.Sprintf(`{"variant":{"id":%d}}`, id)))
+	}
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/variants/3.json", client.pathPrefix),
+		httpmock.NewStringResponder(404, `{"errors":"Not Found"}`))
+
+	result, err := client.Variant.GetMany(context.Background(), []int64{3, 2, 1, 2})
+	if err != nil {
+		t.Fatalf("Variant.GetMany returned error: %v", err)
+	}
+	if len(result.Found) != 2 || result.Found[1].ID != 1 || !reflect.DeepEqual(result.Missing, []int64{3}) {
+		t.Errorf("Variant.GetMany returned %+v, expected variants 1 and 2 with 3 missing", result)
+	}
+	if calls := httpmock.GetTotalCallCount(); calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestGetManyError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products.json", client.pathPrefix),
+		httpmock.NewStringResponder(403, `{"errors":"This action requires merchant approval for read_products scope."}`))
+
+	result, err := client.Product.GetMany(context.Background(), []int64{1})
+	if !errors.Is(err, ErrForbiddenScope) {
+		t.Errorf("Product.GetMany returned %v, expected a forbidden error", err)
+	}
+	if result == nil || len(result.Missing) != 0 {
+		t.Errorf("Product.GetMany returned %+v, expected the id of the failed chunk not missing", result)
+	}
+}
+
+func TestGetManyCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ids := make([]int64, 2000)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err := client.Product.GetMany(ctx, ids)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Product.GetMany returned %v, expected context.Canceled", err)
+	}
+	if len(result.Found) != 0 || len(result.Missing) != 0 {
+		t.Errorf("Product.GetMany returned %+v, expected nothing found nor missing", result)
+	}
+	if calls := httpmock.GetTotalCallCount(); calls != 0 {
+		t.Errorf("expected no call, got %d", calls)
+	}
+
+	// the chunks are not requested once the context is canceled
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	listByIDs := httpmock.NewStringResponder(200, `{"products":[]}`)
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			cancel()
+			return listByIDs(req)
+		})
+	result, err = client.Product.GetMany(ctx, ids)
+	if !errors.Is(err, context.Canceled) || strings.Count(err.Error(), context.Canceled.Error()) != 1 {
+		t.Errorf("Product.GetMany returned %v, expected a single context.Canceled", err)
+	}
+	if calls := httpmock.GetTotalCallCount(); calls > getManyConcurrency {
+		t.Errorf("expected at most %d calls, got %d", getManyConcurrency, calls)
+	}
+	if len(result.Missing) > getManyConcurrency*getManyChunkSize {
+		t.Errorf("Product.GetMany returned %d missing ids, expected only the ids of the requested chunks", len(result.Missing))
+	}
+}
+
+func TestVariantGetManyCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	httpmock.RegisterRegexpResponder("GET", regexp.MustCompile(`/variants/\d+\.json$`),
+		func(req *http.Request) (*http.Response, error) {
+			cancel()
+			return httpmock.NewStringResponse(200, `{"variant":{"id":1}}`), nil
+		})
+
+	ids := make([]int64, 20)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+	result, err := client.Variant.GetMany(ctx, ids)
+	if !errors.Is(err, context.Canceled) || strings.Count(err.Error(), context.Canceled.Error()) != 1 {
+		t.Errorf("Variant.GetMany returned %v, expected a single context.Canceled", err)
+	}
+	if len(result.Missing) != 0 {
+		t.Errorf("Variant.GetMany returned %v missing, expected none", result.Missing)
+	}
+	if calls := httpmock.GetTotalCallCount(); calls > getManyConcurrency {
+		t.Errorf("expected at most %d calls, got %d", getManyConcurrency, calls)
+	}
+}
+
+func TestLoader(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requested := registerListByIDs(t, "products.json", "products", nil)
+	loader := NewLoader(client.Product.GetMany, 0)
+
+	var wg sync.WaitGroup
+	products := make([]*Product, 5)
+	errs := make([]error, 5)
+	for i := range products {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			products[i], errs[i] = loader.Load(context.Background(), int64(i+1))
+		}(i)
+	}
+	wg.Wait()
+
+	if len(*requested) != 1 || (*requested)[0] != "1,2,3,4,5" {
+		t.Errorf("Loader requested %v, expected the ids at once", *requested)
+	}
+	for i := range products {
+		id := int64(i + 1)
+		if id%2 == 0 && (errs[i] != nil || products[i].ID != id) {
+			t.Errorf("Loader.Load(%d) returned %v, %v, expected the product", id, products[i], errs[i])
+		}
+		if id%2 == 1 && !errors.Is(errs[i], ErrNotFound) {
+			t.Errorf("Loader.Load(%d) returned %v, %v, expected a not found error", id, products[i], errs[i])
+		}
+	}
+}
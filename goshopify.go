@@ -15,6 +15,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gempages/go-helper/errors"
@@ -31,6 +32,7 @@ const (
 	defaultApiPathPrefix = "admin"
 	defaultApiVersion    = "stable"
 	defaultHttpTimeout   = 10
+	defaultLogBodyLimit  = 16 * 1024
 )
 
 var (
@@ -69,6 +71,12 @@ type Client struct {
 	// its own client.
 	baseURL *url.URL
 
+	// myshopify domain of the store, kept when the base url is changed
+	shop string
+
+	// first invalid option, returned by NewClientE
+	optionErr error
+
 	// URL Prefix, defaults to "admin" see WithVersion
 	pathPrefix string
 
@@ -79,8 +87,39 @@ type Client struct {
 	token string
 
 	// max number of retries, defaults to 0 for no retries see WithRetry option
-	retries  int
-	attempts int
+	retries int
+
+	// guards the api version and rate limits updated by concurrent calls
+	stateMu sync.Mutex
+
+	// request interceptors, see WithMiddleware
+	middlewares []Middleware
+
+	// serves GET requests, see WithCache
+	cache *Cache
+
+	// receives API usage of every request attempt, see WithMetrics
+	metrics Metrics
+
+	// masks personal data and secrets in logged requests, see WithRedactor
+	redactor *Redactor
+
+	// max number of body bytes logged, see WithLogBodyLimit
+	logBodyLimit int
+
+	// deprecated calls reported by Shopify, see Deprecations
+	deprecationHandler DeprecationHandler
+	deprecationMu      sync.Mutex
+	deprecations       map[string]*Deprecation
+
+	// poll the Location of 202 responses or return a PendingError, see
+	// WithWaitForCompletion and WithPendingErrors
+	waitForCompletion bool
+	pendingErrors     bool
+
+	// refuse or only log the writes, see WithReadOnly and WithDryRun
+	readOnly bool
+	dryRun   bool
 
 	RateLimits RateLimitInfo
 
@@ -120,6 +159,9 @@ type Client struct {
 	InventoryItem              InventoryItemService
 	ShippingZone               ShippingZoneService
 	ProductListing             ProductListingService
+	Policy                     PolicyService
+	Currency                   CurrencyService
+	User                       UserService
 }
 
 // A general response error that follows a similar layout to Shopify's response
@@ -184,6 +226,11 @@ type RateLimitError struct {
 // specified without a preceding slash. If specified, the value pointed to by
 // body is JSON encoded and included as the request body.
 func (c *Client) NewRequest(ctx context.Context, method, relPath string, body, options interface{}) (*http.Request, error) {
+	if c.optionErr != nil {
+		// e.g. an invalid base url, rather than sending the token to the shop
+		return nil, c.optionErr
+	}
+
 	rel, err := url.Parse(relPath)
 	if err != nil {
 		return nil, err
@@ -230,6 +277,9 @@ func (c *Client) NewRequest(ctx context.Context, method, relPath string, body, o
 	} else if c.app.Password != "" {
 		req.SetBasicAuth(c.app.ApiKey, c.app.Password)
 	}
+	for key, values := range requestOptionsFrom(ctx).header {
+		req.Header[key] = append([]string(nil), values...)
+	}
 	return req, nil
 }
 
@@ -252,15 +302,19 @@ func NewClient(app App, shopName, token string, opts ...Option) *Client {
 
 	c := &Client{
 		Client: &http.Client{
-			Timeout:   time.Second * defaultHttpTimeout,
-			Transport: gphttp.NewTracedTransport(nil),
+			Timeout:       time.Second * defaultHttpTimeout,
+			Transport:     gphttp.NewTracedTransport(nil),
+			CheckRedirect: checkRedirect,
 		},
-		log:        &LeveledLogger{},
-		app:        app,
-		baseURL:    baseURL,
-		token:      token,
-		apiVersion: defaultApiVersion,
-		pathPrefix: defaultApiPathPrefix,
+		log:          &LeveledLogger{},
+		metrics:      NoopMetrics{},
+		app:          app,
+		baseURL:      baseURL,
+		shop:         baseURL.Host,
+		token:        token,
+		apiVersion:   defaultApiVersion,
+		pathPrefix:   defaultApiPathPrefix,
+		logBodyLimit: defaultLogBodyLimit,
 	}
 
 	c.Product = &ProductServiceOp{client: c}
@@ -298,15 +352,48 @@ func NewClient(app App, shopName, token string, opts ...Option) *Client {
 	c.InventoryItem = &InventoryItemServiceOp{client: c}
 	c.ShippingZone = &ShippingZoneServiceOp{client: c}
 	c.ProductListing = &ProductListingServiceOp{client: c}
+	c.Policy = &PolicyServiceOp{client: c}
+	c.Currency = &CurrencyServiceOp{client: c}
+	c.User = &UserServiceOp{client: c}
 
 	// apply any options
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.optionErr != nil {
+		c.log.Errorf("%v, the requests of the client are refused", c.optionErr)
+	}
 
 	return c
 }
 
+// NewClientE is like NewClient but returns an error instead of panicking if
+// the shop name is not a valid myshopify domain, see ValidShopDomain, or if
+// an option is invalid, e.g. the url of WithBaseURL
+func NewClientE(app App, shopName, token string, opts ...Option) (*Client, error) {
+	domain, err := ValidShopDomain(shopName)
+	if err != nil {
+		return nil, err
+	}
+	c := NewClient(app, domain, token, opts...)
+	if c.optionErr != nil {
+		return nil, c.optionErr
+	}
+	return c, nil
+}
+
+// requestTarget returns the shop and the resource path of a request url, the
+// shop is the myshopify domain of the client even if the base url is changed
+// and the path is relative to the base url, e.g. "admin/api/2024-01/products.json"
+func (c *Client) requestTarget(u *url.URL) (shop, path string) {
+	shop, path = u.Host, u.Path
+	if u.Host == c.baseURL.Host {
+		shop = c.shop
+		path = strings.TrimPrefix(path, strings.TrimRight(c.baseURL.Path, "/"))
+	}
+	return shop, strings.TrimLeft(path, "/")
+}
+
 // Do sends an API request and populates the given interface with the parsed
 // response. It does not make much sense to call Do without a prepared
 // interface instance.
@@ -321,36 +408,108 @@ func (c *Client) Do(req *http.Request, v interface{}) error {
 
 // doGetHeaders executes a request, decoding the response into `v` and also returns any response headers.
 func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, error) {
+	if headers, handled, err := c.checkWrite(req, v); handled {
+		return headers, err
+	}
+
+	if resp, ok := c.cachedResponse(req); ok {
+		// no request is sent, so nothing is reported to the middlewares and
+		// metrics nor read into the rate limits
+		defer resp.Body.Close()
+		return decodeResponse(resp, v)
+	}
+
+	headers, err := c.doRequest(req, v)
+	if pending, ok := err.(PendingError); ok {
+		if c.waitForCompletion {
+			return c.poll(req.Context(), pending, v)
+		}
+		if pending.decoded || !c.pendingErrors {
+			// the accepted resource was decoded like any other response, or
+			// the call succeeds like before 202 responses were handled
+			return headers, nil
+		}
+	}
+	return headers, err
+}
+
+// doRequest executes a request with retries and redirections, decoding the
+// response into `v`. A 202 response with a Location returns a PendingError.
+func (c *Client) doRequest(req *http.Request, v interface{}) (http.Header, error) {
 	var resp *http.Response
 	var err error
 	retries := c.retries
-	c.attempts = 0
+	if o := requestOptionsFrom(req.Context()); o.retries != nil {
+		retries = *o.retries
+	}
+	redirects := 0
+	attempt := 0
 	c.logRequest(req)
 
 	for {
-		c.attempts++
-		resp, err = c.Client.Do(req)
+		attempt++
+		if attempt > 1 && req.GetBody != nil {
+			// the body was consumed by the previous attempt
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+		start := time.Now()
+		resp, err = c.send(req, attempt)
+		latency := time.Since(start)
 		c.logResponse(resp)
 		if err != nil {
+			c.observeAttempt(req, attempt, nil, err, latency, 0)
 			return nil, err // http client errors, not api responses
 		}
 
+		if resp.StatusCode == http.StatusSeeOther && resp.Header.Get("Location") != "" {
+			// the result is retrieved with a GET on the Location
+			resp.Body.Close()
+			c.observeAttempt(req, attempt, resp, nil, latency, 0)
+			redirects++
+			if redirects > maxRedirects {
+				return nil, fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			req, err = seeOtherRequest(req, resp)
+			if err != nil {
+				return nil, err
+			}
+			c.logRequest(req)
+			// the GET of the Location is not a retry
+			attempt = 0
+			continue
+		}
+
 		respErr := CheckResponseError(resp)
 		if respErr == nil {
+			c.observeAttempt(req, attempt, resp, nil, latency, 0)
 			break // no errors, break out of the retry loop
 		}
 
 		// retry scenario, close resp and any continue will retry
 		resp.Body.Close()
 
+		var wait time.Duration
+		rateLimitErr, isRetryErr := respErr.(RateLimitError)
+		if isRetryErr && retries > 1 {
+			wait = time.Duration(rateLimitErr.RetryAfter) * time.Second
+		}
+		c.observeAttempt(req, attempt, resp, nil, latency, wait)
+
 		if retries <= 1 {
 			return nil, respErr
 		}
 
-		if rateLimitErr, isRetryErr := respErr.(RateLimitError); isRetryErr {
-			// back off and retry
+		if req.GetBody == nil && req.Body != nil && req.Body != http.NoBody {
+			// the body was consumed and can't be sent again
+			c.log.Debugf("request body can't be resent, not retrying")
+			return nil, respErr
+		}
 
-			wait := time.Duration(rateLimitErr.RetryAfter) * time.Second
+		if isRetryErr {
+			// back off and retry
 			c.log.Debugf("rate limited waiting %s", wait.String())
 			time.Sleep(wait)
 			retries--
@@ -373,16 +532,46 @@ func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, er
 		return nil, respErr
 	}
 
-	c.logResponse(resp)
 	defer resp.Body.Close()
 
-	if c.apiVersion == defaultApiVersion && resp.Header.Get("X-Shopify-API-Version") != "" {
-		// if using stable on first request set the api version
-		c.apiVersion = resp.Header.Get("X-Shopify-API-Version")
-		c.log.Infof("api version not set, now using %s", c.apiVersion)
+	if servedVersion := resp.Header.Get("X-Shopify-API-Version"); servedVersion != "" {
+		apiVersion, pathPrefix := c.requestVersion(req.Context())
+		if apiVersion == defaultApiVersion {
+			// if using stable on first request set the api version
+			c.stateMu.Lock()
+			c.apiVersion = servedVersion
+			c.stateMu.Unlock()
+			c.log.Infof("api version not set, now using %s", servedVersion)
+		} else if pathPrefix != defaultApiPathPrefix && servedVersion != apiVersion {
+			// Shopify serves requests for sunset versions with the oldest supported one
+			c.log.Warnf("api version %s requested but %s served, it may be unsupported", apiVersion, servedVersion)
+		}
+	}
+
+	c.stateMu.Lock()
+	if requestCount, bucketSize, ok := parseCallLimit(resp.Header); ok {
+		c.RateLimits.RequestCount = requestCount
+		c.RateLimits.BucketSize = bucketSize
 	}
 
-	if v != nil {
+	c.RateLimits.RetryAfterSeconds, _ = strconv.ParseFloat(resp.Header.Get("Retry-After"), 64)
+	c.stateMu.Unlock()
+
+	if resp.StatusCode == http.StatusAccepted && resp.Header.Get("Location") != "" {
+		return c.accepted(req, resp, v)
+	}
+
+	return decodeResponse(resp, v)
+}
+
+// decodeResponse decodes the body of a successful response into `v`
+func decodeResponse(resp *http.Response, v interface{}) (http.Header, error) {
+	if streamer, ok := v.(responseStreamer); ok {
+		err := streamer.streamResponse(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("decode response: %w", err)
+		}
+	} else if v != nil {
 		decoder := json.NewDecoder(resp.Body)
 		err := decoder.Decode(&v)
 		if err != nil {
@@ -390,43 +579,84 @@ func (c *Client) doGetHeaders(req *http.Request, v interface{}) (http.Header, er
 		}
 	}
 
-	if s := strings.Split(resp.Header.Get("X-Shopify-Shop-Api-Call-Limit"), "/"); len(s) == 2 {
-		c.RateLimits.RequestCount, _ = strconv.Atoi(s[0])
-		c.RateLimits.BucketSize, _ = strconv.Atoi(s[1])
-	}
-
-	c.RateLimits.RetryAfterSeconds, _ = strconv.ParseFloat(resp.Header.Get("Retry-After"), 64)
-
 	return resp.Header, nil
 }
 
+// parseCallLimit parses the X-Shopify-Shop-Api-Call-Limit header, e.g. "32/40"
+func parseCallLimit(header http.Header) (requestCount, bucketSize int, ok bool) {
+	s := strings.Split(header.Get("X-Shopify-Shop-Api-Call-Limit"), "/")
+	if len(s) != 2 {
+		return 0, 0, false
+	}
+	requestCount, _ = strconv.Atoi(s[0])
+	bucketSize, _ = strconv.Atoi(s[1])
+	return requestCount, bucketSize, true
+}
+
 func (c *Client) logRequest(req *http.Request) {
-	if req == nil {
+	if req == nil || !c.debugEnabled() {
 		return
 	}
 	if req.URL != nil {
-		c.log.Debugf("%s: %s", req.Method, req.URL.String())
+		if c.redactor != nil {
+			c.log.Debugf("%s: %s", req.Method, c.redactor.RedactURL(req.URL))
+		} else {
+			c.log.Debugf("%s: %s", req.Method, req.URL.String())
+		}
 	}
 	c.logBody(&req.Body, "SENT: %s")
 }
 
 func (c *Client) logResponse(res *http.Response) {
-	if res == nil {
+	if res == nil || !c.debugEnabled() {
 		return
 	}
 	c.log.Debugf("RECV %d: %s", res.StatusCode, res.Status)
 	c.logBody(&res.Body, "RESP: %s")
 }
 
+// logBody logs at most logBodyLimit bytes of the body. Only the logged part
+// is buffered, the rest of the body is still streamed from the original reader.
 func (c *Client) logBody(body *io.ReadCloser, format string) {
-	if body == nil {
+	if body == nil || *body == nil || *body == http.NoBody {
+		return
+	}
+
+	original := *body
+	b, _ := io.ReadAll(io.LimitReader(original, int64(c.logBodyLimit)+1))
+	*body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(b), original), original}
+
+	if len(b) == 0 {
+		return
+	}
+
+	if len(b) > c.logBodyLimit {
+		if c.redactor != nil {
+			// a truncated JSON body can't be redacted reliably
+			c.log.Debugf(format, fmt.Sprintf("<body over %d bytes not logged>", c.logBodyLimit))
+			return
+		}
+		c.log.Debugf(format, string(b[:c.logBodyLimit])+"...")
 		return
 	}
-	b, _ := io.ReadAll(*body)
-	if len(b) > 0 {
+
+	if c.redactor != nil {
+		c.log.Debugf(format, string(c.redactor.RedactBody(b)))
+	} else {
 		c.log.Debugf(format, string(b))
 	}
-	*body = io.NopCloser(bytes.NewBuffer(b))
+}
+
+// debugEnabled returns false if the logger reports that it drops debug
+// messages, in which case request and response bodies are not read for logging
+func (c *Client) debugEnabled() bool {
+	if logger, ok := c.log.(DebugEnabledLogger); ok {
+		return logger.DebugEnabled()
+	}
+	return true
 }
 
 func wrapSpecificError(r *http.Response, err ResponseError) error {
@@ -443,12 +673,6 @@ func wrapSpecificError(r *http.Response, err ResponseError) error {
 		}
 	}
 
-	// if err.Status == http.StatusSeeOther {
-	// todo
-	// The response to the request can be found under a different URL in the
-	// Location header and can be retrieved using a GET method on that resource.
-	// }
-
 	if err.Status == http.StatusNotAcceptable {
 		err.Message = http.StatusText(err.Status)
 	}
@@ -513,6 +737,7 @@ func CheckResponseError(r *http.Response) error {
 
 	// If the errors field is not filled out, we can return here.
 	if shopifyError.Errors == nil {
+		// a 422 without field errors stays a ResponseError, as it always was
 		return wrapSpecificError(r, responseError)
 	}
 
@@ -568,6 +793,9 @@ func CheckResponseError(r *http.Response) error {
 		}
 	}
 
+	if r.StatusCode == http.StatusUnprocessableEntity {
+		return newValidationError(r, responseError, shopifyError.Errors)
+	}
 	return wrapSpecificError(r, responseError)
 }
 
@@ -621,7 +849,7 @@ func (c *Client) CreateAndDo(ctx context.Context, method, relPath string, data,
 	_, err := c.createAndDoGetHeaders(ctx, method, relPath, data, options, resource)
 	if err != nil {
 		if respErr, ok := err.(RateLimitError); ok {
-			return errors.NewServiceUnavailableError(respErr.Status, "Shopify responded: "+respErr.Message)
+			return causeError{errors.NewServiceUnavailableError(respErr.Status, "Shopify responded: "+respErr.Message), respErr}
 		}
 		if respErr, ok := err.(ResponseDecodingError); ok {
 			return errors.NewErrorWithContext(ctx, respErr, map[string]any{
@@ -631,11 +859,11 @@ func (c *Client) CreateAndDo(ctx context.Context, method, relPath string, data,
 		}
 		if respErr, ok := err.(ResponseError); ok {
 			if respErr.Status >= http.StatusInternalServerError && respErr.Status <= http.StatusGatewayTimeout {
-				return errors.NewServiceUnavailableError(respErr.Status, "Shopify responded: "+respErr.Message)
+				return causeError{errors.NewServiceUnavailableError(respErr.Status, "Shopify responded: "+respErr.Message), respErr}
 			}
 			if respErr.Status == http.StatusUnauthorized {
 				// Should not return AuthenticationError from our lib here to avoid misunderstanding
-				return errors.NewValidationError(respErr.Status, "Shopify responded: "+respErr.Message)
+				return causeError{errors.NewValidationError(respErr.Status, "Shopify responded: "+respErr.Message), respErr}
 			}
 		}
 		return err
@@ -650,7 +878,8 @@ func (c *Client) createAndDoGetHeaders(ctx context.Context, method, relPath stri
 		relPath = strings.TrimLeft(relPath, "/")
 	}
 
-	relPath = path.Join(c.pathPrefix, relPath)
+	_, pathPrefix := c.requestVersion(ctx)
+	relPath = path.Join(pathPrefix, relPath)
 	req, err := c.NewRequest(ctx, method, relPath, data, options)
 	if err != nil {
 		return nil, err
@@ -686,6 +915,8 @@ func IsOperationUrlEmptyError(err error) bool {
 	return err != nil && strings.Contains(err.Error(), "Operation result URL is empty")
 }
 
+// IsInvalidTokenError reports whether err is an ErrUnauthorized error or the
+// message of one
 func IsInvalidTokenError(err error) bool {
-	return err != nil && strings.Contains(err.Error(), "Invalid API key or access token")
+	return err != nil && (isError(err, ErrUnauthorized) || strings.Contains(err.Error(), "Invalid API key or access token"))
 }
@@ -73,6 +73,34 @@ func TestNewClient(t *testing.T) {
 	}
 }
 
+func TestNewClientE(t *testing.T) {
+	testClient, err := NewClientE(app, "FooShop.myshopify.com", "abcd", WithVersion(testApiVersion))
+	if err != nil {
+		t.Fatalf("NewClientE returned error: %v", err)
+	}
+	expected := "https://fooshop.myshopify.com"
+	if testClient.baseURL.String() != expected {
+		t.Errorf("NewClientE BaseURL = %v, expected %v", testClient.baseURL.String(), expected)
+	}
+
+	cases := []struct {
+		shopName string
+		opts     []Option
+		expected string
+	}{
+		{"fooshop.myshopify.com.evil.com", nil, `invalid shop domain "fooshop.myshopify.com.evil.com", expected <shop>.myshopify.com`},
+		{"", nil, `invalid shop domain "", expected <shop>.myshopify.com`},
+		{"fooshop", []Option{WithBaseURL("/relative")}, `invalid base url "/relative", expected an absolute url`},
+		{"fooshop", []Option{WithBaseURL("ftp://proxy.example.com")}, `invalid base url "ftp://proxy.example.com", expected an http or https url`},
+	}
+	for _, c := range cases {
+		testClient, err := NewClientE(app, c.shopName, "abcd", c.opts...)
+		if testClient != nil || err == nil || err.Error() != c.expected {
+			t.Errorf("NewClientE(%q) returned %v, %v, expected error %q", c.shopName, testClient, err, c.expected)
+		}
+	}
+}
+
 func TestNewClientWithNoToken(t *testing.T) {
 	testClient := NewClient(app, "fooshop", "", WithVersion(testApiVersion))
 	expected := "https://fooshop.myshopify.com"
@@ -359,8 +387,14 @@ func TestRetry(t *testing.T) {
 		Foo string `json:"foo"`
 	}
 
-	var retries int
+	var retries, attempts int
 	urlFormat := "https://fooshop.myshopify.com/%s"
+	client.middlewares = []Middleware{func(next RequestHandler) RequestHandler {
+		return func(req *http.Request, info RequestInfo) (*http.Response, error) {
+			attempts = info.Attempt
+			return next(req, info)
+		}
+	}}
 
 	cases := []struct {
 		relPath   string
@@ -443,8 +477,8 @@ func TestRetry(t *testing.T) {
 
 		err = client.Do(req, body)
 
-		if client.attempts != c.retries {
-			t.Errorf("Do(): attempts do not match retries %#v, actual %#v", client.attempts, c.retries)
+		if attempts != c.retries {
+			t.Errorf("Do(): attempts do not match retries %#v, actual %#v", attempts, c.retries)
 		}
 
 		if err != nil {
@@ -463,6 +497,90 @@ func TestRetry(t *testing.T) {
 	}
 }
 
+func TestRetryResendsBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var bodies []string
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/foo/1",
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			bodies = append(bodies, string(b))
+			if len(bodies) == 1 {
+				return httpmock.NewStringResponse(http.StatusServiceUnavailable, ""), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{}`), nil
+		})
+
+	req, err := client.NewRequest(context.Background(), "POST", "foo/1", map[string]string{"foo": "bar"}, nil)
+	if err != nil {
+		t.Fatal("error creating request: ", err)
+	}
+
+	err = client.Do(req, nil)
+	if err != nil {
+		t.Fatalf("Do(): returned error %v", err)
+	}
+
+	expected := []string{`{"foo":"bar"}`, `{"foo":"bar"}`}
+	if !reflect.DeepEqual(bodies, expected) {
+		t.Errorf("Do(): sent bodies %v, expected %v", bodies, expected)
+	}
+}
+
+func TestRetryResendsServiceBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// the logged request body must be sent again too
+	client.log = &LeveledLogger{Level: LevelDebug, stdoutOverride: ioutil.Discard}
+
+	var bodies []string
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/products.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			b, _ := ioutil.ReadAll(req.Body)
+			bodies = append(bodies, string(b))
+			if len(bodies) == 1 {
+				resp := httpmock.NewStringResponse(http.StatusTooManyRequests, `{"errors":"Exceeded 2 calls per second for api client."}`)
+				resp.Header.Set("Retry-After", "0")
+				return resp, nil
+			}
+			return httpmock.NewStringResponse(http.StatusCreated, `{"product":{"id":1}}`), nil
+		})
+
+	product, err := client.Product.Create(context.Background(), Product{Title: "foo"})
+	if err != nil {
+		t.Fatalf("Product.Create returned error: %v", err)
+	}
+	if product.ID != 1 || len(bodies) != 2 || bodies[1] != bodies[0] || !strings.Contains(bodies[1], `"title":"foo"`) {
+		t.Errorf("Product.Create sent bodies %q, expected the product twice", bodies)
+	}
+}
+
+func TestRetryUnrewindableBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", "https://fooshop.myshopify.com/foo/1",
+		httpmock.NewStringResponder(http.StatusServiceUnavailable, ""))
+
+	req, err := client.NewRequest(context.Background(), "POST", "foo/1", nil, nil)
+	if err != nil {
+		t.Fatal("error creating request: ", err)
+	}
+	// a body which can't be read again
+	req.Body = ioutil.NopCloser(strings.NewReader(`{"foo":"bar"}`))
+	req.GetBody = nil
+
+	err = client.Do(req, nil)
+	if respErr, ok := err.(ResponseError); !ok || respErr.Status != http.StatusServiceUnavailable {
+		t.Errorf("Do(): returned error %v, expected a service unavailable error", err)
+	}
+	if calls := httpmock.GetTotalCallCount(); calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
 func TestClientDoAutoApiVersion(t *testing.T) {
 	u := "foo/1"
 	responder := func(req *http.Request) (*http.Response, error) {
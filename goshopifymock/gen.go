@@ -0,0 +1,22 @@
+//go:build ignore
+
+// gen.go writes mocks_gen.go from the service interfaces of the goshopify
+// package, it is run by go generate
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/gempages/go-shopify/goshopifymock/internal/mockgen"
+)
+
+func main() {
+	src, err := mockgen.Generate("..")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("mocks_gen.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,404 @@
+// Package mockgen generates the mocks of goshopifymock from the service
+// interfaces declared in the goshopify package
+package mockgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// method is a method of a service interface
+type method struct {
+	name    string
+	typ     *ast.FuncType
+	imports map[string]string // imports of the declaring file by name
+}
+
+// clientField is a service field of goshopify.Client
+type clientField struct {
+	name    string
+	service string
+}
+
+// generator holds the declarations of the goshopify package
+type generator struct {
+	declared   map[string]bool
+	interfaces map[string]*ast.InterfaceType
+	imports    map[string]map[string]string // file imports by interface
+	used       map[string]string            // imports of the mocks by name
+}
+
+// Generate returns the source of the mocks of all the interfaces named
+// *Service in the goshopify package in dir, and of the NewClient helper
+func Generate(dir string) ([]byte, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	pkg, ok := pkgs["goshopify"]
+	if !ok {
+		return nil, fmt.Errorf("mockgen: no goshopify package in %s", dir)
+	}
+
+	g := &generator{
+		declared:   map[string]bool{},
+		interfaces: map[string]*ast.InterfaceType{},
+		imports:    map[string]map[string]string{},
+		used:       map[string]string{},
+	}
+
+	filenames := make([]string, 0, len(pkg.Files))
+	for filename := range pkg.Files {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	var services []string
+	var client *ast.StructType
+	for _, filename := range filenames {
+		file := pkg.Files[filename]
+		imports := map[string]string{}
+		for _, imp := range file.Imports {
+			path, _ := strconv.Unquote(imp.Path.Value)
+			name := path[strings.LastIndex(path, "/")+1:]
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+			imports[name] = path
+		}
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				g.declared[ts.Name.Name] = true
+				switch t := ts.Type.(type) {
+				case *ast.InterfaceType:
+					g.interfaces[ts.Name.Name] = t
+					g.imports[ts.Name.Name] = imports
+					if strings.HasSuffix(ts.Name.Name, "Service") && ts.Name.IsExported() {
+						services = append(services, ts.Name.Name)
+					}
+				case *ast.StructType:
+					if ts.Name.Name == "Client" {
+						client = t
+					}
+				}
+			}
+		}
+	}
+	if client == nil {
+		return nil, fmt.Errorf("mockgen: no Client struct in %s", dir)
+	}
+	sort.Strings(services)
+
+	var fields []clientField
+	for _, field := range client.Fields.List {
+		ident, ok := field.Type.(*ast.Ident)
+		if !ok || g.interfaces[ident.Name] == nil || !strings.HasSuffix(ident.Name, "Service") {
+			continue
+		}
+		for _, name := range field.Names {
+			fields = append(fields, clientField{name: name.Name, service: ident.Name})
+		}
+	}
+
+	body := new(bytes.Buffer)
+	for _, service := range services {
+		methods, err := g.methods(service)
+		if err != nil {
+			return nil, err
+		}
+		if err := g.writeMock(body, service, methods); err != nil {
+			return nil, err
+		}
+	}
+	g.writeClient(body, fields)
+
+	out := new(bytes.Buffer)
+	out.WriteString("// Code generated by go run gen.go. DO NOT EDIT.\n\n")
+	out.WriteString("package goshopifymock\n\n")
+	out.WriteString("import (\n")
+	var names []string
+	for name := range g.used {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		path := g.used[name]
+		if path[strings.LastIndex(path, "/")+1:] == name {
+			fmt.Fprintf(out, "\t%q\n", path)
+		} else {
+			fmt.Fprintf(out, "\t%s %q\n", name, path)
+		}
+	}
+	out.WriteString("\n\tgoshopify \"github.com/gempages/go-shopify\"\n)\n")
+	out.Write(body.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("mockgen: format: %w", err)
+	}
+	return src, nil
+}
+
+// methods returns the methods of an interface in declaration order, with
+// the methods of embedded interfaces in place
+func (g *generator) methods(name string) ([]method, error) {
+	iface, ok := g.interfaces[name]
+	if !ok {
+		return nil, fmt.Errorf("mockgen: unknown interface %s", name)
+	}
+	var methods []method
+	for _, field := range iface.Methods.List {
+		switch t := field.Type.(type) {
+		case *ast.FuncType:
+			for _, n := range field.Names {
+				methods = append(methods, method{name: n.Name, typ: t, imports: g.imports[name]})
+			}
+		case *ast.Ident:
+			embedded, err := g.methods(t.Name)
+			if err != nil {
+				return nil, err
+			}
+			methods = append(methods, embedded...)
+		default:
+			return nil, fmt.Errorf("mockgen: unsupported embedded type in %s", name)
+		}
+	}
+	return methods, nil
+}
+
+func (g *generator) writeMock(w *bytes.Buffer, service string, methods []method) error {
+	fmt.Fprintf(w, "\n// %s is a mock of goshopify.%s\n", service, service)
+	fmt.Fprintf(w, "type %s struct {\n\tMock\n", service)
+	for _, m := range methods {
+		typ, err := g.typeString(m.typ, m.imports)
+		if err != nil {
+			return fmt.Errorf("mockgen: %s.%s: %w", service, m.name, err)
+		}
+		fmt.Fprintf(w, "\n\t// %sFunc is called by %s if set\n", m.name, m.name)
+		fmt.Fprintf(w, "\t%sFunc %s\n", m.name, typ)
+	}
+	w.WriteString("}\n")
+	fmt.Fprintf(w, "\nvar _ goshopify.%s = (*%s)(nil)\n", service, service)
+
+	for _, m := range methods {
+		var params, args, callArgs []string
+		for _, field := range m.typ.Params.List {
+			typ, err := g.typeString(field.Type, m.imports)
+			if err != nil {
+				return err
+			}
+			n := len(field.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				arg := fmt.Sprintf("arg%d", len(args))
+				params = append(params, arg+" "+typ)
+				args = append(args, arg)
+				if _, variadic := field.Type.(*ast.Ellipsis); variadic {
+					callArgs = append(callArgs, arg+"...")
+				} else {
+					callArgs = append(callArgs, arg)
+				}
+			}
+		}
+
+		var results []string
+		if m.typ.Results != nil {
+			for _, field := range m.typ.Results.List {
+				typ, err := g.typeString(field.Type, m.imports)
+				if err != nil {
+					return err
+				}
+				n := len(field.Names)
+				if n == 0 {
+					n = 1
+				}
+				for i := 0; i < n; i++ {
+					results = append(results, typ)
+				}
+			}
+		}
+
+		resultList := strings.Join(results, ", ")
+		if len(results) > 1 {
+			resultList = "(" + resultList + ")"
+		}
+		fmt.Fprintf(w, "\n// %s records the call and returns the results of %sFunc\n", m.name, m.name)
+		fmt.Fprintf(w, "func (m *%s) %s(%s) %s {\n", service, m.name, strings.Join(params, ", "), resultList)
+		fmt.Fprintf(w, "\tm.record(%q, %s)\n", m.name, strings.Join(args, ", "))
+		fmt.Fprintf(w, "\tif m.%sFunc != nil {\n", m.name)
+		if len(results) > 0 {
+			fmt.Fprintf(w, "\t\treturn m.%sFunc(%s)\n\t}\n", m.name, strings.Join(callArgs, ", "))
+		} else {
+			fmt.Fprintf(w, "\t\tm.%sFunc(%s)\n\t}\n", m.name, strings.Join(callArgs, ", "))
+		}
+
+		var zeros []string
+		for i, typ := range results {
+			if i == len(results)-1 && typ == "error" {
+				zeros = append(zeros, fmt.Sprintf("m.unexpected(%q, %q)", service, m.name))
+				continue
+			}
+			fmt.Fprintf(w, "\tvar r%d %s\n", i, typ)
+			zeros = append(zeros, fmt.Sprintf("r%d", i))
+		}
+		if len(zeros) > 0 {
+			fmt.Fprintf(w, "\treturn %s\n", strings.Join(zeros, ", "))
+		}
+		w.WriteString("}\n")
+	}
+	return nil
+}
+
+func (g *generator) writeClient(w *bytes.Buffer, fields []clientField) {
+	w.WriteString("\n// Services holds the mocks of the services of a client built by NewClient\n")
+	w.WriteString("type Services struct {\n")
+	for _, f := range fields {
+		fmt.Fprintf(w, "\t%s *%s\n", f.name, f.service)
+	}
+	w.WriteString("}\n")
+
+	w.WriteString("\n// NewClient returns a client with all its services replaced by new mocks,\n")
+	w.WriteString("// and the mocks to configure\n")
+	w.WriteString("func NewClient(opts ...goshopify.Option) (*goshopify.Client, *Services) {\n")
+	w.WriteString("\tmocks := &Services{\n")
+	for _, f := range fields {
+		fmt.Fprintf(w, "\t\t%s: &%s{},\n", f.name, f.service)
+	}
+	w.WriteString("\t}\n\n")
+	w.WriteString("\tclient := goshopify.NewClient(goshopify.App{}, \"fooshop\", \"\", opts...)\n")
+	for _, f := range fields {
+		fmt.Fprintf(w, "\tclient.%s = mocks.%s\n", f.name, f.name)
+	}
+	w.WriteString("\treturn client, mocks\n}\n")
+}
+
+// genericString returns the source of an instantiated generic type
+func (g *generator) genericString(generic ast.Expr, args []ast.Expr, imports map[string]string) (string, error) {
+	s, err := g.typeString(generic, imports)
+	if err != nil {
+		return "", err
+	}
+	var params []string
+	for _, arg := range args {
+		param, err := g.typeString(arg, imports)
+		if err != nil {
+			return "", err
+		}
+		params = append(params, param)
+	}
+	return s + "[" + strings.Join(params, ", ") + "]", nil
+}
+
+// typeString returns the source of a type of the goshopify package as used
+// from another package, given the imports of the file declaring it
+func (g *generator) typeString(expr ast.Expr, imports map[string]string) (string, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if g.declared[t.Name] {
+			if !t.IsExported() {
+				return "", fmt.Errorf("unexported type %s", t.Name)
+			}
+			return "goshopify." + t.Name, nil
+		}
+		return t.Name, nil
+	case *ast.SelectorExpr:
+		pkg, ok := t.X.(*ast.Ident)
+		if !ok {
+			return "", fmt.Errorf("unsupported selector")
+		}
+		path, ok := imports[pkg.Name]
+		if !ok {
+			return "", fmt.Errorf("unknown package %s", pkg.Name)
+		}
+		if used, ok := g.used[pkg.Name]; ok && used != path {
+			return "", fmt.Errorf("package name %s used for %s and %s", pkg.Name, used, path)
+		}
+		g.used[pkg.Name] = path
+		return pkg.Name + "." + t.Sel.Name, nil
+	case *ast.StarExpr:
+		s, err := g.typeString(t.X, imports)
+		return "*" + s, err
+	case *ast.IndexExpr:
+		return g.genericString(t.X, []ast.Expr{t.Index}, imports)
+	case *ast.IndexListExpr:
+		return g.genericString(t.X, t.Indices, imports)
+	case *ast.Ellipsis:
+		s, err := g.typeString(t.Elt, imports)
+		return "..." + s, err
+	case *ast.ArrayType:
+		s, err := g.typeString(t.Elt, imports)
+		if err != nil {
+			return "", err
+		}
+		if t.Len == nil {
+			return "[]" + s, nil
+		}
+		lit, ok := t.Len.(*ast.BasicLit)
+		if !ok {
+			return "", fmt.Errorf("unsupported array length")
+		}
+		return "[" + lit.Value + "]" + s, nil
+	case *ast.MapType:
+		k, err := g.typeString(t.Key, imports)
+		if err != nil {
+			return "", err
+		}
+		v, err := g.typeString(t.Value, imports)
+		return "map[" + k + "]" + v, err
+	case *ast.InterfaceType:
+		if len(t.Methods.List) > 0 {
+			return "", fmt.Errorf("unsupported interface literal")
+		}
+		return "interface{}", nil
+	case *ast.FuncType:
+		var params, results []string
+		for _, field := range t.Params.List {
+			s, err := g.typeString(field.Type, imports)
+			if err != nil {
+				return "", err
+			}
+			for i := 0; i < max(1, len(field.Names)); i++ {
+				params = append(params, s)
+			}
+		}
+		if t.Results != nil {
+			for _, field := range t.Results.List {
+				s, err := g.typeString(field.Type, imports)
+				if err != nil {
+					return "", err
+				}
+				for i := 0; i < max(1, len(field.Names)); i++ {
+					results = append(results, s)
+				}
+			}
+		}
+		s := "func(" + strings.Join(params, ", ") + ")"
+		switch len(results) {
+		case 0:
+		case 1:
+			s += " " + results[0]
+		default:
+			s += " (" + strings.Join(results, ", ") + ")"
+		}
+		return s, nil
+	}
+	return "", fmt.Errorf("unsupported type %T", expr)
+}
@@ -0,0 +1,74 @@
+// Package goshopifymock provides call-recording mocks of every goshopify
+// service interface, and NewClient to build a client using them:
+//
+//	client, mocks := goshopifymock.NewClient()
+//	mocks.Product.GetFunc = func(ctx context.Context, id int64, options interface{}) (*goshopify.Product, error) {
+//		return &goshopify.Product{ID: id, Title: "Shirt"}, nil
+//	}
+//	product, err := client.Product.Get(ctx, 1, nil)
+//	calls := mocks.Product.CallsTo("Get")
+//
+// Methods without their function set return zero values and an error
+// wrapping ErrUnexpectedCall. The mocks are generated from the goshopify
+// sources by gen.go, run go generate after changing a service interface.
+package goshopifymock
+
+//go:generate go run gen.go
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrUnexpectedCall is returned by mock methods whose function is not set
+var ErrUnexpectedCall = errors.New("goshopifymock: unexpected call")
+
+// Call is a recorded call of a mock method
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Mock records the calls of a mock, it is embedded in every mock
+type Mock struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the recorded calls in order
+func (m *Mock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// CallsTo returns the recorded calls of a method in order
+func (m *Mock) CallsTo(method string) []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var calls []Call
+	for _, call := range m.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the recorded calls
+func (m *Mock) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = nil
+}
+
+func (m *Mock) record(method string, args ...interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+}
+
+func (m *Mock) unexpected(service, method string) error {
+	return fmt.Errorf("%w to %s.%s", ErrUnexpectedCall, service, method)
+}
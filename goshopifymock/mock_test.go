@@ -0,0 +1,86 @@
+package goshopifymock
+
+import (
+	"context"
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+
+	goshopify "github.com/gempages/go-shopify"
+	"github.com/gempages/go-shopify/goshopifymock/internal/mockgen"
+)
+
+func TestMocksUpToDate(t *testing.T) {
+	expected, err := mockgen.Generate("..")
+	if err != nil {
+		t.Fatalf("mockgen.Generate returned error: %v", err)
+	}
+	actual, err := os.ReadFile("mocks_gen.go")
+	if err != nil {
+		t.Fatalf("cannot read mocks_gen.go: %v", err)
+	}
+	if string(actual) != string(expected) {
+		t.Errorf("mocks_gen.go is out of date, run go generate ./goshopifymock")
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	client, mocks := NewClient()
+	ctx := context.Background()
+
+	mocks.Product.GetFunc = func(ctx context.Context, id int64, options interface{}) (*goshopify.Product, error) {
+		return &goshopify.Product{ID: id, Title: "Shirt"}, nil
+	}
+
+	product, err := client.Product.Get(ctx, 1, nil)
+	if err != nil {
+		t.Fatalf("Product.Get returned error: %v", err)
+	}
+	if product.ID != 1 || product.Title != "Shirt" {
+		t.Errorf("Product.Get returned %+v, expected the mocked product", product)
+	}
+
+	calls := mocks.Product.CallsTo("Get")
+	expected := []Call{{Method: "Get", Args: []interface{}{ctx, int64(1), nil}}}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Product.CallsTo(Get) returned %+v, expected %+v", calls, expected)
+	}
+
+	mocks.Product.Reset()
+	if calls := mocks.Product.Calls(); len(calls) != 0 {
+		t.Errorf("Product.Calls after Reset returned %+v, expected none", calls)
+	}
+}
+
+func TestUnexpectedCall(t *testing.T) {
+	client, mocks := NewClient()
+
+	orders, err := client.Order.List(context.Background(), nil)
+	if !errors.Is(err, ErrUnexpectedCall) || orders != nil {
+		t.Errorf("Order.List returned %v, %v, expected ErrUnexpectedCall", orders, err)
+	}
+	if err.Error() != "goshopifymock: unexpected call to OrderService.List" {
+		t.Errorf("Order.List returned error %q", err)
+	}
+	if n := len(mocks.Order.Calls()); n != 1 {
+		t.Errorf("Order.Calls returned %d calls, expected 1", n)
+	}
+}
+
+func TestEmbeddedServiceMethods(t *testing.T) {
+	client, mocks := NewClient()
+
+	var deleted []int64
+	mocks.Customer.DeleteMetafieldFunc = func(ctx context.Context, customerID, metafieldID int64) error {
+		deleted = append(deleted, customerID, metafieldID)
+		return nil
+	}
+
+	if err := client.Customer.DeleteMetafield(context.Background(), 1, 2); err != nil {
+		t.Fatalf("Customer.DeleteMetafield returned error: %v", err)
+	}
+	if !reflect.DeepEqual(deleted, []int64{1, 2}) {
+		t.Errorf("DeleteMetafieldFunc called with %v, expected [1 2]", deleted)
+	}
+}
@@ -0,0 +1,4104 @@
+// Code generated by go run gen.go. DO NOT EDIT.
+
+package goshopifymock
+
+import (
+	"context"
+
+	goshopify "github.com/gempages/go-shopify"
+)
+
+// AccessScopeService is a mock of goshopify.AccessScopeService
+type AccessScopeService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.AccessScope, error)
+}
+
+var _ goshopify.AccessScopeService = (*AccessScopeService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *AccessScopeService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.AccessScope, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.AccessScope
+	return r0, m.unexpected("AccessScopeService", "List")
+}
+
+// ApplicationChargeService is a mock of goshopify.ApplicationChargeService
+type ApplicationChargeService struct {
+	Mock
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.ApplicationCharge) (*goshopify.ApplicationCharge, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.ApplicationCharge, error)
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.ApplicationCharge, error)
+
+	// ActivateFunc is called by Activate if set
+	ActivateFunc func(context.Context, goshopify.ApplicationCharge) (*goshopify.ApplicationCharge, error)
+}
+
+var _ goshopify.ApplicationChargeService = (*ApplicationChargeService)(nil)
+
+// Create records the call and returns the results of CreateFunc
+func (m *ApplicationChargeService) Create(arg0 context.Context, arg1 goshopify.ApplicationCharge) (*goshopify.ApplicationCharge, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.ApplicationCharge
+	return r0, m.unexpected("ApplicationChargeService", "Create")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *ApplicationChargeService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.ApplicationCharge, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.ApplicationCharge
+	return r0, m.unexpected("ApplicationChargeService", "Get")
+}
+
+// List records the call and returns the results of ListFunc
+func (m *ApplicationChargeService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.ApplicationCharge, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.ApplicationCharge
+	return r0, m.unexpected("ApplicationChargeService", "List")
+}
+
+// Activate records the call and returns the results of ActivateFunc
+func (m *ApplicationChargeService) Activate(arg0 context.Context, arg1 goshopify.ApplicationCharge) (*goshopify.ApplicationCharge, error) {
+	m.record("Activate", arg0, arg1)
+	if m.ActivateFunc != nil {
+		return m.ActivateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.ApplicationCharge
+	return r0, m.unexpected("ApplicationChargeService", "Activate")
+}
+
+// ArticleService is a mock of goshopify.ArticleService
+type ArticleService struct {
+	Mock
+
+	// GetByBlogIDFunc is called by GetByBlogID if set
+	GetByBlogIDFunc func(context.Context, int64, int, int64, *goshopify.ArticleQueryOptions) (*[]goshopify.Article, error)
+
+	// GetByBlogIDAndArticleIDFunc is called by GetByBlogIDAndArticleID if set
+	GetByBlogIDAndArticleIDFunc func(context.Context, int64, int64, *goshopify.ArticleQueryOptions) (*goshopify.Article, error)
+
+	// GetCountByBlogIDFunc is called by GetCountByBlogID if set
+	GetCountByBlogIDFunc func(context.Context, int64, interface{}) (int, error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, int64, *goshopify.Article) (*goshopify.Article, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, int64, int64, *goshopify.Article) (*goshopify.Article, error)
+
+	// PatchFunc is called by Patch if set
+	PatchFunc func(context.Context, int64, int64, *goshopify.Patch[goshopify.Article]) (*goshopify.Article, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64, int64) error
+}
+
+var _ goshopify.ArticleService = (*ArticleService)(nil)
+
+// GetByBlogID records the call and returns the results of GetByBlogIDFunc
+func (m *ArticleService) GetByBlogID(arg0 context.Context, arg1 int64, arg2 int, arg3 int64, arg4 *goshopify.ArticleQueryOptions) (*[]goshopify.Article, error) {
+	m.record("GetByBlogID", arg0, arg1, arg2, arg3, arg4)
+	if m.GetByBlogIDFunc != nil {
+		return m.GetByBlogIDFunc(arg0, arg1, arg2, arg3, arg4)
+	}
+	var r0 *[]goshopify.Article
+	return r0, m.unexpected("ArticleService", "GetByBlogID")
+}
+
+// GetByBlogIDAndArticleID records the call and returns the results of GetByBlogIDAndArticleIDFunc
+func (m *ArticleService) GetByBlogIDAndArticleID(arg0 context.Context, arg1 int64, arg2 int64, arg3 *goshopify.ArticleQueryOptions) (*goshopify.Article, error) {
+	m.record("GetByBlogIDAndArticleID", arg0, arg1, arg2, arg3)
+	if m.GetByBlogIDAndArticleIDFunc != nil {
+		return m.GetByBlogIDAndArticleIDFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.Article
+	return r0, m.unexpected("ArticleService", "GetByBlogIDAndArticleID")
+}
+
+// GetCountByBlogID records the call and returns the results of GetCountByBlogIDFunc
+func (m *ArticleService) GetCountByBlogID(arg0 context.Context, arg1 int64, arg2 interface{}) (int, error) {
+	m.record("GetCountByBlogID", arg0, arg1, arg2)
+	if m.GetCountByBlogIDFunc != nil {
+		return m.GetCountByBlogIDFunc(arg0, arg1, arg2)
+	}
+	var r0 int
+	return r0, m.unexpected("ArticleService", "GetCountByBlogID")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *ArticleService) Create(arg0 context.Context, arg1 int64, arg2 *goshopify.Article) (*goshopify.Article, error) {
+	m.record("Create", arg0, arg1, arg2)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Article
+	return r0, m.unexpected("ArticleService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *ArticleService) Update(arg0 context.Context, arg1 int64, arg2 int64, arg3 *goshopify.Article) (*goshopify.Article, error) {
+	m.record("Update", arg0, arg1, arg2, arg3)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.Article
+	return r0, m.unexpected("ArticleService", "Update")
+}
+
+// Patch records the call and returns the results of PatchFunc
+func (m *ArticleService) Patch(arg0 context.Context, arg1 int64, arg2 int64, arg3 *goshopify.Patch[goshopify.Article]) (*goshopify.Article, error) {
+	m.record("Patch", arg0, arg1, arg2, arg3)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.Article
+	return r0, m.unexpected("ArticleService", "Patch")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *ArticleService) Delete(arg0 context.Context, arg1 int64, arg2 int64) error {
+	m.record("Delete", arg0, arg1, arg2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("ArticleService", "Delete")
+}
+
+// AssetService is a mock of goshopify.AssetService
+type AssetService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, int64, interface{}) ([]goshopify.Asset, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, string) (*goshopify.Asset, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, int64, goshopify.Asset) (*goshopify.Asset, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64, string) error
+}
+
+var _ goshopify.AssetService = (*AssetService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *AssetService) List(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Asset, error) {
+	m.record("List", arg0, arg1, arg2)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Asset
+	return r0, m.unexpected("AssetService", "List")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *AssetService) Get(arg0 context.Context, arg1 int64, arg2 string) (*goshopify.Asset, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Asset
+	return r0, m.unexpected("AssetService", "Get")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *AssetService) Update(arg0 context.Context, arg1 int64, arg2 goshopify.Asset) (*goshopify.Asset, error) {
+	m.record("Update", arg0, arg1, arg2)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Asset
+	return r0, m.unexpected("AssetService", "Update")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *AssetService) Delete(arg0 context.Context, arg1 int64, arg2 string) error {
+	m.record("Delete", arg0, arg1, arg2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("AssetService", "Delete")
+}
+
+// BlogService is a mock of goshopify.BlogService
+type BlogService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.Blog, error)
+
+	// GetBySinceIdFunc is called by GetBySinceId if set
+	GetBySinceIdFunc func(context.Context, int64, int, interface{}) ([]goshopify.Blog, error)
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, interface{}) (int, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.Blog, error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.Blog) (*goshopify.Blog, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, goshopify.Blog) (*goshopify.Blog, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64) error
+}
+
+var _ goshopify.BlogService = (*BlogService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *BlogService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.Blog, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.Blog
+	return r0, m.unexpected("BlogService", "List")
+}
+
+// GetBySinceId records the call and returns the results of GetBySinceIdFunc
+func (m *BlogService) GetBySinceId(arg0 context.Context, arg1 int64, arg2 int, arg3 interface{}) ([]goshopify.Blog, error) {
+	m.record("GetBySinceId", arg0, arg1, arg2, arg3)
+	if m.GetBySinceIdFunc != nil {
+		return m.GetBySinceIdFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 []goshopify.Blog
+	return r0, m.unexpected("BlogService", "GetBySinceId")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *BlogService) Count(arg0 context.Context, arg1 interface{}) (int, error) {
+	m.record("Count", arg0, arg1)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1)
+	}
+	var r0 int
+	return r0, m.unexpected("BlogService", "Count")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *BlogService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.Blog, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Blog
+	return r0, m.unexpected("BlogService", "Get")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *BlogService) Create(arg0 context.Context, arg1 goshopify.Blog) (*goshopify.Blog, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Blog
+	return r0, m.unexpected("BlogService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *BlogService) Update(arg0 context.Context, arg1 goshopify.Blog) (*goshopify.Blog, error) {
+	m.record("Update", arg0, arg1)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Blog
+	return r0, m.unexpected("BlogService", "Update")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *BlogService) Delete(arg0 context.Context, arg1 int64) error {
+	m.record("Delete", arg0, arg1)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1)
+	}
+	return m.unexpected("BlogService", "Delete")
+}
+
+// CollectService is a mock of goshopify.CollectService
+type CollectService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.Collect, error)
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, interface{}) (int, error)
+}
+
+var _ goshopify.CollectService = (*CollectService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *CollectService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.Collect, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.Collect
+	return r0, m.unexpected("CollectService", "List")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *CollectService) Count(arg0 context.Context, arg1 interface{}) (int, error) {
+	m.record("Count", arg0, arg1)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1)
+	}
+	var r0 int
+	return r0, m.unexpected("CollectService", "Count")
+}
+
+// CollectionService is a mock of goshopify.CollectionService
+type CollectionService struct {
+	Mock
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.Collection, error)
+
+	// ListProductsFunc is called by ListProducts if set
+	ListProductsFunc func(context.Context, int64, interface{}) ([]goshopify.Product, error)
+
+	// ListProductsWithPaginationFunc is called by ListProductsWithPagination if set
+	ListProductsWithPaginationFunc func(context.Context, int64, interface{}) ([]goshopify.Product, *goshopify.Pagination, error)
+}
+
+var _ goshopify.CollectionService = (*CollectionService)(nil)
+
+// Get records the call and returns the results of GetFunc
+func (m *CollectionService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.Collection, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Collection
+	return r0, m.unexpected("CollectionService", "Get")
+}
+
+// ListProducts records the call and returns the results of ListProductsFunc
+func (m *CollectionService) ListProducts(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Product, error) {
+	m.record("ListProducts", arg0, arg1, arg2)
+	if m.ListProductsFunc != nil {
+		return m.ListProductsFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Product
+	return r0, m.unexpected("CollectionService", "ListProducts")
+}
+
+// ListProductsWithPagination records the call and returns the results of ListProductsWithPaginationFunc
+func (m *CollectionService) ListProductsWithPagination(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Product, *goshopify.Pagination, error) {
+	m.record("ListProductsWithPagination", arg0, arg1, arg2)
+	if m.ListProductsWithPaginationFunc != nil {
+		return m.ListProductsWithPaginationFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Product
+	var r1 *goshopify.Pagination
+	return r0, r1, m.unexpected("CollectionService", "ListProductsWithPagination")
+}
+
+// CurrencyService is a mock of goshopify.CurrencyService
+type CurrencyService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context) ([]goshopify.Currency, error)
+}
+
+var _ goshopify.CurrencyService = (*CurrencyService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *CurrencyService) List(arg0 context.Context) ([]goshopify.Currency, error) {
+	m.record("List", arg0)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0)
+	}
+	var r0 []goshopify.Currency
+	return r0, m.unexpected("CurrencyService", "List")
+}
+
+// CustomCollectionService is a mock of goshopify.CustomCollectionService
+type CustomCollectionService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.CustomCollection, error)
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, interface{}) (int, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.CustomCollection, error)
+
+	// GetManyFunc is called by GetMany if set
+	GetManyFunc func(context.Context, []int64) (*goshopify.GetManyResult[goshopify.CustomCollection], error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.CustomCollection) (*goshopify.CustomCollection, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, goshopify.CustomCollection) (*goshopify.CustomCollection, error)
+
+	// PatchFunc is called by Patch if set
+	PatchFunc func(context.Context, int64, *goshopify.Patch[goshopify.CustomCollection]) (*goshopify.CustomCollection, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64) error
+
+	// ListMetafieldsFunc is called by ListMetafields if set
+	ListMetafieldsFunc func(context.Context, int64, interface{}) ([]goshopify.Metafield, error)
+
+	// CountMetafieldsFunc is called by CountMetafields if set
+	CountMetafieldsFunc func(context.Context, int64, interface{}) (int, error)
+
+	// GetMetafieldFunc is called by GetMetafield if set
+	GetMetafieldFunc func(context.Context, int64, int64, interface{}) (*goshopify.Metafield, error)
+
+	// CreateMetafieldFunc is called by CreateMetafield if set
+	CreateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// UpdateMetafieldFunc is called by UpdateMetafield if set
+	UpdateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// DeleteMetafieldFunc is called by DeleteMetafield if set
+	DeleteMetafieldFunc func(context.Context, int64, int64) error
+}
+
+var _ goshopify.CustomCollectionService = (*CustomCollectionService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *CustomCollectionService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.CustomCollection, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.CustomCollection
+	return r0, m.unexpected("CustomCollectionService", "List")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *CustomCollectionService) Count(arg0 context.Context, arg1 interface{}) (int, error) {
+	m.record("Count", arg0, arg1)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1)
+	}
+	var r0 int
+	return r0, m.unexpected("CustomCollectionService", "Count")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *CustomCollectionService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.CustomCollection, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.CustomCollection
+	return r0, m.unexpected("CustomCollectionService", "Get")
+}
+
+// GetMany records the call and returns the results of GetManyFunc
+func (m *CustomCollectionService) GetMany(arg0 context.Context, arg1 []int64) (*goshopify.GetManyResult[goshopify.CustomCollection], error) {
+	m.record("GetMany", arg0, arg1)
+	if m.GetManyFunc != nil {
+		return m.GetManyFunc(arg0, arg1)
+	}
+	var r0 *goshopify.GetManyResult[goshopify.CustomCollection]
+	return r0, m.unexpected("CustomCollectionService", "GetMany")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *CustomCollectionService) Create(arg0 context.Context, arg1 goshopify.CustomCollection) (*goshopify.CustomCollection, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.CustomCollection
+	return r0, m.unexpected("CustomCollectionService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *CustomCollectionService) Update(arg0 context.Context, arg1 goshopify.CustomCollection) (*goshopify.CustomCollection, error) {
+	m.record("Update", arg0, arg1)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.CustomCollection
+	return r0, m.unexpected("CustomCollectionService", "Update")
+}
+
+// Patch records the call and returns the results of PatchFunc
+func (m *CustomCollectionService) Patch(arg0 context.Context, arg1 int64, arg2 *goshopify.Patch[goshopify.CustomCollection]) (*goshopify.CustomCollection, error) {
+	m.record("Patch", arg0, arg1, arg2)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.CustomCollection
+	return r0, m.unexpected("CustomCollectionService", "Patch")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *CustomCollectionService) Delete(arg0 context.Context, arg1 int64) error {
+	m.record("Delete", arg0, arg1)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1)
+	}
+	return m.unexpected("CustomCollectionService", "Delete")
+}
+
+// ListMetafields records the call and returns the results of ListMetafieldsFunc
+func (m *CustomCollectionService) ListMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Metafield, error) {
+	m.record("ListMetafields", arg0, arg1, arg2)
+	if m.ListMetafieldsFunc != nil {
+		return m.ListMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Metafield
+	return r0, m.unexpected("CustomCollectionService", "ListMetafields")
+}
+
+// CountMetafields records the call and returns the results of CountMetafieldsFunc
+func (m *CustomCollectionService) CountMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) (int, error) {
+	m.record("CountMetafields", arg0, arg1, arg2)
+	if m.CountMetafieldsFunc != nil {
+		return m.CountMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 int
+	return r0, m.unexpected("CustomCollectionService", "CountMetafields")
+}
+
+// GetMetafield records the call and returns the results of GetMetafieldFunc
+func (m *CustomCollectionService) GetMetafield(arg0 context.Context, arg1 int64, arg2 int64, arg3 interface{}) (*goshopify.Metafield, error) {
+	m.record("GetMetafield", arg0, arg1, arg2, arg3)
+	if m.GetMetafieldFunc != nil {
+		return m.GetMetafieldFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("CustomCollectionService", "GetMetafield")
+}
+
+// CreateMetafield records the call and returns the results of CreateMetafieldFunc
+func (m *CustomCollectionService) CreateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("CreateMetafield", arg0, arg1, arg2)
+	if m.CreateMetafieldFunc != nil {
+		return m.CreateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("CustomCollectionService", "CreateMetafield")
+}
+
+// UpdateMetafield records the call and returns the results of UpdateMetafieldFunc
+func (m *CustomCollectionService) UpdateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("UpdateMetafield", arg0, arg1, arg2)
+	if m.UpdateMetafieldFunc != nil {
+		return m.UpdateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("CustomCollectionService", "UpdateMetafield")
+}
+
+// DeleteMetafield records the call and returns the results of DeleteMetafieldFunc
+func (m *CustomCollectionService) DeleteMetafield(arg0 context.Context, arg1 int64, arg2 int64) error {
+	m.record("DeleteMetafield", arg0, arg1, arg2)
+	if m.DeleteMetafieldFunc != nil {
+		return m.DeleteMetafieldFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("CustomCollectionService", "DeleteMetafield")
+}
+
+// CustomerAddressService is a mock of goshopify.CustomerAddressService
+type CustomerAddressService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, int64, interface{}) ([]goshopify.CustomerAddress, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, int64, interface{}) (*goshopify.CustomerAddress, error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, int64, goshopify.CustomerAddress) (*goshopify.CustomerAddress, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, int64, goshopify.CustomerAddress) (*goshopify.CustomerAddress, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64, int64) error
+
+	// SetDefaultFunc is called by SetDefault if set
+	SetDefaultFunc func(context.Context, int64, int64) (*goshopify.CustomerAddress, error)
+
+	// DeleteMultipleFunc is called by DeleteMultiple if set
+	DeleteMultipleFunc func(context.Context, int64, []int64) error
+}
+
+var _ goshopify.CustomerAddressService = (*CustomerAddressService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *CustomerAddressService) List(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.CustomerAddress, error) {
+	m.record("List", arg0, arg1, arg2)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.CustomerAddress
+	return r0, m.unexpected("CustomerAddressService", "List")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *CustomerAddressService) Get(arg0 context.Context, arg1 int64, arg2 int64, arg3 interface{}) (*goshopify.CustomerAddress, error) {
+	m.record("Get", arg0, arg1, arg2, arg3)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.CustomerAddress
+	return r0, m.unexpected("CustomerAddressService", "Get")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *CustomerAddressService) Create(arg0 context.Context, arg1 int64, arg2 goshopify.CustomerAddress) (*goshopify.CustomerAddress, error) {
+	m.record("Create", arg0, arg1, arg2)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.CustomerAddress
+	return r0, m.unexpected("CustomerAddressService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *CustomerAddressService) Update(arg0 context.Context, arg1 int64, arg2 goshopify.CustomerAddress) (*goshopify.CustomerAddress, error) {
+	m.record("Update", arg0, arg1, arg2)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.CustomerAddress
+	return r0, m.unexpected("CustomerAddressService", "Update")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *CustomerAddressService) Delete(arg0 context.Context, arg1 int64, arg2 int64) error {
+	m.record("Delete", arg0, arg1, arg2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("CustomerAddressService", "Delete")
+}
+
+// SetDefault records the call and returns the results of SetDefaultFunc
+func (m *CustomerAddressService) SetDefault(arg0 context.Context, arg1 int64, arg2 int64) (*goshopify.CustomerAddress, error) {
+	m.record("SetDefault", arg0, arg1, arg2)
+	if m.SetDefaultFunc != nil {
+		return m.SetDefaultFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.CustomerAddress
+	return r0, m.unexpected("CustomerAddressService", "SetDefault")
+}
+
+// DeleteMultiple records the call and returns the results of DeleteMultipleFunc
+func (m *CustomerAddressService) DeleteMultiple(arg0 context.Context, arg1 int64, arg2 []int64) error {
+	m.record("DeleteMultiple", arg0, arg1, arg2)
+	if m.DeleteMultipleFunc != nil {
+		return m.DeleteMultipleFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("CustomerAddressService", "DeleteMultiple")
+}
+
+// CustomerSavedSearchService is a mock of goshopify.CustomerSavedSearchService
+type CustomerSavedSearchService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.CustomerSavedSearch, error)
+
+	// GetBySinceIdFunc is called by GetBySinceId if set
+	GetBySinceIdFunc func(context.Context, int64, int, interface{}) ([]goshopify.CustomerSavedSearch, error)
+
+	// ListMetafieldsFunc is called by ListMetafields if set
+	ListMetafieldsFunc func(context.Context, int64, interface{}) ([]goshopify.Metafield, error)
+
+	// CountMetafieldsFunc is called by CountMetafields if set
+	CountMetafieldsFunc func(context.Context, int64, interface{}) (int, error)
+
+	// GetMetafieldFunc is called by GetMetafield if set
+	GetMetafieldFunc func(context.Context, int64, int64, interface{}) (*goshopify.Metafield, error)
+
+	// CreateMetafieldFunc is called by CreateMetafield if set
+	CreateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// UpdateMetafieldFunc is called by UpdateMetafield if set
+	UpdateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// DeleteMetafieldFunc is called by DeleteMetafield if set
+	DeleteMetafieldFunc func(context.Context, int64, int64) error
+}
+
+var _ goshopify.CustomerSavedSearchService = (*CustomerSavedSearchService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *CustomerSavedSearchService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.CustomerSavedSearch, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.CustomerSavedSearch
+	return r0, m.unexpected("CustomerSavedSearchService", "List")
+}
+
+// GetBySinceId records the call and returns the results of GetBySinceIdFunc
+func (m *CustomerSavedSearchService) GetBySinceId(arg0 context.Context, arg1 int64, arg2 int, arg3 interface{}) ([]goshopify.CustomerSavedSearch, error) {
+	m.record("GetBySinceId", arg0, arg1, arg2, arg3)
+	if m.GetBySinceIdFunc != nil {
+		return m.GetBySinceIdFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 []goshopify.CustomerSavedSearch
+	return r0, m.unexpected("CustomerSavedSearchService", "GetBySinceId")
+}
+
+// ListMetafields records the call and returns the results of ListMetafieldsFunc
+func (m *CustomerSavedSearchService) ListMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Metafield, error) {
+	m.record("ListMetafields", arg0, arg1, arg2)
+	if m.ListMetafieldsFunc != nil {
+		return m.ListMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Metafield
+	return r0, m.unexpected("CustomerSavedSearchService", "ListMetafields")
+}
+
+// CountMetafields records the call and returns the results of CountMetafieldsFunc
+func (m *CustomerSavedSearchService) CountMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) (int, error) {
+	m.record("CountMetafields", arg0, arg1, arg2)
+	if m.CountMetafieldsFunc != nil {
+		return m.CountMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 int
+	return r0, m.unexpected("CustomerSavedSearchService", "CountMetafields")
+}
+
+// GetMetafield records the call and returns the results of GetMetafieldFunc
+func (m *CustomerSavedSearchService) GetMetafield(arg0 context.Context, arg1 int64, arg2 int64, arg3 interface{}) (*goshopify.Metafield, error) {
+	m.record("GetMetafield", arg0, arg1, arg2, arg3)
+	if m.GetMetafieldFunc != nil {
+		return m.GetMetafieldFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("CustomerSavedSearchService", "GetMetafield")
+}
+
+// CreateMetafield records the call and returns the results of CreateMetafieldFunc
+func (m *CustomerSavedSearchService) CreateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("CreateMetafield", arg0, arg1, arg2)
+	if m.CreateMetafieldFunc != nil {
+		return m.CreateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("CustomerSavedSearchService", "CreateMetafield")
+}
+
+// UpdateMetafield records the call and returns the results of UpdateMetafieldFunc
+func (m *CustomerSavedSearchService) UpdateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("UpdateMetafield", arg0, arg1, arg2)
+	if m.UpdateMetafieldFunc != nil {
+		return m.UpdateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("CustomerSavedSearchService", "UpdateMetafield")
+}
+
+// DeleteMetafield records the call and returns the results of DeleteMetafieldFunc
+func (m *CustomerSavedSearchService) DeleteMetafield(arg0 context.Context, arg1 int64, arg2 int64) error {
+	m.record("DeleteMetafield", arg0, arg1, arg2)
+	if m.DeleteMetafieldFunc != nil {
+		return m.DeleteMetafieldFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("CustomerSavedSearchService", "DeleteMetafield")
+}
+
+// CustomerService is a mock of goshopify.CustomerService
+type CustomerService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.Customer, error)
+
+	// GetBySinceIdFunc is called by GetBySinceId if set
+	GetBySinceIdFunc func(context.Context, int64, int, interface{}) ([]goshopify.Customer, error)
+
+	// StreamCustomersFunc is called by StreamCustomers if set
+	StreamCustomersFunc func(context.Context, interface{}, func(goshopify.Customer) error) error
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, interface{}) (int, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.Customer, error)
+
+	// GetManyFunc is called by GetMany if set
+	GetManyFunc func(context.Context, []int64) (*goshopify.GetManyResult[goshopify.Customer], error)
+
+	// SearchFunc is called by Search if set
+	SearchFunc func(context.Context, interface{}) ([]goshopify.Customer, error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.Customer) (*goshopify.Customer, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, goshopify.Customer) (*goshopify.Customer, error)
+
+	// PatchFunc is called by Patch if set
+	PatchFunc func(context.Context, int64, *goshopify.Patch[goshopify.Customer]) (*goshopify.Customer, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64) error
+
+	// ListOrdersFunc is called by ListOrders if set
+	ListOrdersFunc func(context.Context, int64, interface{}) ([]goshopify.Order, error)
+
+	// ListTagsFunc is called by ListTags if set
+	ListTagsFunc func(context.Context, interface{}) ([]string, error)
+
+	// AccountActivationURLFunc is called by AccountActivationURL if set
+	AccountActivationURLFunc func(context.Context, int64) (string, error)
+
+	// SendInviteFunc is called by SendInvite if set
+	SendInviteFunc func(context.Context, int64, goshopify.CustomerInvite) (*goshopify.CustomerInvite, error)
+
+	// ListMetafieldsFunc is called by ListMetafields if set
+	ListMetafieldsFunc func(context.Context, int64, interface{}) ([]goshopify.Metafield, error)
+
+	// CountMetafieldsFunc is called by CountMetafields if set
+	CountMetafieldsFunc func(context.Context, int64, interface{}) (int, error)
+
+	// GetMetafieldFunc is called by GetMetafield if set
+	GetMetafieldFunc func(context.Context, int64, int64, interface{}) (*goshopify.Metafield, error)
+
+	// CreateMetafieldFunc is called by CreateMetafield if set
+	CreateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// UpdateMetafieldFunc is called by UpdateMetafield if set
+	UpdateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// DeleteMetafieldFunc is called by DeleteMetafield if set
+	DeleteMetafieldFunc func(context.Context, int64, int64) error
+}
+
+var _ goshopify.CustomerService = (*CustomerService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *CustomerService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.Customer, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.Customer
+	return r0, m.unexpected("CustomerService", "List")
+}
+
+// GetBySinceId records the call and returns the results of GetBySinceIdFunc
+func (m *CustomerService) GetBySinceId(arg0 context.Context, arg1 int64, arg2 int, arg3 interface{}) ([]goshopify.Customer, error) {
+	m.record("GetBySinceId", arg0, arg1, arg2, arg3)
+	if m.GetBySinceIdFunc != nil {
+		return m.GetBySinceIdFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 []goshopify.Customer
+	return r0, m.unexpected("CustomerService", "GetBySinceId")
+}
+
+// StreamCustomers records the call and returns the results of StreamCustomersFunc
+func (m *CustomerService) StreamCustomers(arg0 context.Context, arg1 interface{}, arg2 func(goshopify.Customer) error) error {
+	m.record("StreamCustomers", arg0, arg1, arg2)
+	if m.StreamCustomersFunc != nil {
+		return m.StreamCustomersFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("CustomerService", "StreamCustomers")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *CustomerService) Count(arg0 context.Context, arg1 interface{}) (int, error) {
+	m.record("Count", arg0, arg1)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1)
+	}
+	var r0 int
+	return r0, m.unexpected("CustomerService", "Count")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *CustomerService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.Customer, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Customer
+	return r0, m.unexpected("CustomerService", "Get")
+}
+
+// GetMany records the call and returns the results of GetManyFunc
+func (m *CustomerService) GetMany(arg0 context.Context, arg1 []int64) (*goshopify.GetManyResult[goshopify.Customer], error) {
+	m.record("GetMany", arg0, arg1)
+	if m.GetManyFunc != nil {
+		return m.GetManyFunc(arg0, arg1)
+	}
+	var r0 *goshopify.GetManyResult[goshopify.Customer]
+	return r0, m.unexpected("CustomerService", "GetMany")
+}
+
+// Search records the call and returns the results of SearchFunc
+func (m *CustomerService) Search(arg0 context.Context, arg1 interface{}) ([]goshopify.Customer, error) {
+	m.record("Search", arg0, arg1)
+	if m.SearchFunc != nil {
+		return m.SearchFunc(arg0, arg1)
+	}
+	var r0 []goshopify.Customer
+	return r0, m.unexpected("CustomerService", "Search")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *CustomerService) Create(arg0 context.Context, arg1 goshopify.Customer) (*goshopify.Customer, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Customer
+	return r0, m.unexpected("CustomerService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *CustomerService) Update(arg0 context.Context, arg1 goshopify.Customer) (*goshopify.Customer, error) {
+	m.record("Update", arg0, arg1)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Customer
+	return r0, m.unexpected("CustomerService", "Update")
+}
+
+// Patch records the call and returns the results of PatchFunc
+func (m *CustomerService) Patch(arg0 context.Context, arg1 int64, arg2 *goshopify.Patch[goshopify.Customer]) (*goshopify.Customer, error) {
+	m.record("Patch", arg0, arg1, arg2)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Customer
+	return r0, m.unexpected("CustomerService", "Patch")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *CustomerService) Delete(arg0 context.Context, arg1 int64) error {
+	m.record("Delete", arg0, arg1)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1)
+	}
+	return m.unexpected("CustomerService", "Delete")
+}
+
+// ListOrders records the call and returns the results of ListOrdersFunc
+func (m *CustomerService) ListOrders(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Order, error) {
+	m.record("ListOrders", arg0, arg1, arg2)
+	if m.ListOrdersFunc != nil {
+		return m.ListOrdersFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Order
+	return r0, m.unexpected("CustomerService", "ListOrders")
+}
+
+// ListTags records the call and returns the results of ListTagsFunc
+func (m *CustomerService) ListTags(arg0 context.Context, arg1 interface{}) ([]string, error) {
+	m.record("ListTags", arg0, arg1)
+	if m.ListTagsFunc != nil {
+		return m.ListTagsFunc(arg0, arg1)
+	}
+	var r0 []string
+	return r0, m.unexpected("CustomerService", "ListTags")
+}
+
+// AccountActivationURL records the call and returns the results of AccountActivationURLFunc
+func (m *CustomerService) AccountActivationURL(arg0 context.Context, arg1 int64) (string, error) {
+	m.record("AccountActivationURL", arg0, arg1)
+	if m.AccountActivationURLFunc != nil {
+		return m.AccountActivationURLFunc(arg0, arg1)
+	}
+	var r0 string
+	return r0, m.unexpected("CustomerService", "AccountActivationURL")
+}
+
+// SendInvite records the call and returns the results of SendInviteFunc
+func (m *CustomerService) SendInvite(arg0 context.Context, arg1 int64, arg2 goshopify.CustomerInvite) (*goshopify.CustomerInvite, error) {
+	m.record("SendInvite", arg0, arg1, arg2)
+	if m.SendInviteFunc != nil {
+		return m.SendInviteFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.CustomerInvite
+	return r0, m.unexpected("CustomerService", "SendInvite")
+}
+
+// ListMetafields records the call and returns the results of ListMetafieldsFunc
+func (m *CustomerService) ListMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Metafield, error) {
+	m.record("ListMetafields", arg0, arg1, arg2)
+	if m.ListMetafieldsFunc != nil {
+		return m.ListMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Metafield
+	return r0, m.unexpected("CustomerService", "ListMetafields")
+}
+
+// CountMetafields records the call and returns the results of CountMetafieldsFunc
+func (m *CustomerService) CountMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) (int, error) {
+	m.record("CountMetafields", arg0, arg1, arg2)
+	if m.CountMetafieldsFunc != nil {
+		return m.CountMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 int
+	return r0, m.unexpected("CustomerService", "CountMetafields")
+}
+
+// GetMetafield records the call and returns the results of GetMetafieldFunc
+func (m *CustomerService) GetMetafield(arg0 context.Context, arg1 int64, arg2 int64, arg3 interface{}) (*goshopify.Metafield, error) {
+	m.record("GetMetafield", arg0, arg1, arg2, arg3)
+	if m.GetMetafieldFunc != nil {
+		return m.GetMetafieldFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("CustomerService", "GetMetafield")
+}
+
+// CreateMetafield records the call and returns the results of CreateMetafieldFunc
+func (m *CustomerService) CreateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("CreateMetafield", arg0, arg1, arg2)
+	if m.CreateMetafieldFunc != nil {
+		return m.CreateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("CustomerService", "CreateMetafield")
+}
+
+// UpdateMetafield records the call and returns the results of UpdateMetafieldFunc
+func (m *CustomerService) UpdateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("UpdateMetafield", arg0, arg1, arg2)
+	if m.UpdateMetafieldFunc != nil {
+		return m.UpdateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("CustomerService", "UpdateMetafield")
+}
+
+// DeleteMetafield records the call and returns the results of DeleteMetafieldFunc
+func (m *CustomerService) DeleteMetafield(arg0 context.Context, arg1 int64, arg2 int64) error {
+	m.record("DeleteMetafield", arg0, arg1, arg2)
+	if m.DeleteMetafieldFunc != nil {
+		return m.DeleteMetafieldFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("CustomerService", "DeleteMetafield")
+}
+
+// DiscountCodeService is a mock of goshopify.DiscountCodeService
+type DiscountCodeService struct {
+	Mock
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, int64, goshopify.PriceRuleDiscountCode) (*goshopify.PriceRuleDiscountCode, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, int64, goshopify.PriceRuleDiscountCode) (*goshopify.PriceRuleDiscountCode, error)
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, int64) ([]goshopify.PriceRuleDiscountCode, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, int64) (*goshopify.PriceRuleDiscountCode, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64, int64) error
+
+	// LookupFunc is called by Lookup if set
+	LookupFunc func(context.Context, string) (*goshopify.PriceRuleDiscountCode, error)
+
+	// CreateBatchFunc is called by CreateBatch if set
+	CreateBatchFunc func(context.Context, int64, []string) ([]goshopify.DiscountCodeCreation, error)
+
+	// GetBatchFunc is called by GetBatch if set
+	GetBatchFunc func(context.Context, int64, int64) (*goshopify.DiscountCodeCreation, error)
+
+	// ListBatchCodesFunc is called by ListBatchCodes if set
+	ListBatchCodesFunc func(context.Context, int64, int64) ([]goshopify.PriceRuleDiscountCode, error)
+
+	// WaitForBatchFunc is called by WaitForBatch if set
+	WaitForBatchFunc func(context.Context, int64, int64) (*goshopify.DiscountCodeCreation, []goshopify.PriceRuleDiscountCode, error)
+}
+
+var _ goshopify.DiscountCodeService = (*DiscountCodeService)(nil)
+
+// Create records the call and returns the results of CreateFunc
+func (m *DiscountCodeService) Create(arg0 context.Context, arg1 int64, arg2 goshopify.PriceRuleDiscountCode) (*goshopify.PriceRuleDiscountCode, error) {
+	m.record("Create", arg0, arg1, arg2)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.PriceRuleDiscountCode
+	return r0, m.unexpected("DiscountCodeService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *DiscountCodeService) Update(arg0 context.Context, arg1 int64, arg2 goshopify.PriceRuleDiscountCode) (*goshopify.PriceRuleDiscountCode, error) {
+	m.record("Update", arg0, arg1, arg2)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.PriceRuleDiscountCode
+	return r0, m.unexpected("DiscountCodeService", "Update")
+}
+
+// List records the call and returns the results of ListFunc
+func (m *DiscountCodeService) List(arg0 context.Context, arg1 int64) ([]goshopify.PriceRuleDiscountCode, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.PriceRuleDiscountCode
+	return r0, m.unexpected("DiscountCodeService", "List")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *DiscountCodeService) Get(arg0 context.Context, arg1 int64, arg2 int64) (*goshopify.PriceRuleDiscountCode, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.PriceRuleDiscountCode
+	return r0, m.unexpected("DiscountCodeService", "Get")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *DiscountCodeService) Delete(arg0 context.Context, arg1 int64, arg2 int64) error {
+	m.record("Delete", arg0, arg1, arg2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("DiscountCodeService", "Delete")
+}
+
+// Lookup records the call and returns the results of LookupFunc
+func (m *DiscountCodeService) Lookup(arg0 context.Context, arg1 string) (*goshopify.PriceRuleDiscountCode, error) {
+	m.record("Lookup", arg0, arg1)
+	if m.LookupFunc != nil {
+		return m.LookupFunc(arg0, arg1)
+	}
+	var r0 *goshopify.PriceRuleDiscountCode
+	return r0, m.unexpected("DiscountCodeService", "Lookup")
+}
+
+// CreateBatch records the call and returns the results of CreateBatchFunc
+func (m *DiscountCodeService) CreateBatch(arg0 context.Context, arg1 int64, arg2 []string) ([]goshopify.DiscountCodeCreation, error) {
+	m.record("CreateBatch", arg0, arg1, arg2)
+	if m.CreateBatchFunc != nil {
+		return m.CreateBatchFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.DiscountCodeCreation
+	return r0, m.unexpected("DiscountCodeService", "CreateBatch")
+}
+
+// GetBatch records the call and returns the results of GetBatchFunc
+func (m *DiscountCodeService) GetBatch(arg0 context.Context, arg1 int64, arg2 int64) (*goshopify.DiscountCodeCreation, error) {
+	m.record("GetBatch", arg0, arg1, arg2)
+	if m.GetBatchFunc != nil {
+		return m.GetBatchFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.DiscountCodeCreation
+	return r0, m.unexpected("DiscountCodeService", "GetBatch")
+}
+
+// ListBatchCodes records the call and returns the results of ListBatchCodesFunc
+func (m *DiscountCodeService) ListBatchCodes(arg0 context.Context, arg1 int64, arg2 int64) ([]goshopify.PriceRuleDiscountCode, error) {
+	m.record("ListBatchCodes", arg0, arg1, arg2)
+	if m.ListBatchCodesFunc != nil {
+		return m.ListBatchCodesFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.PriceRuleDiscountCode
+	return r0, m.unexpected("DiscountCodeService", "ListBatchCodes")
+}
+
+// WaitForBatch records the call and returns the results of WaitForBatchFunc
+func (m *DiscountCodeService) WaitForBatch(arg0 context.Context, arg1 int64, arg2 int64) (*goshopify.DiscountCodeCreation, []goshopify.PriceRuleDiscountCode, error) {
+	m.record("WaitForBatch", arg0, arg1, arg2)
+	if m.WaitForBatchFunc != nil {
+		return m.WaitForBatchFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.DiscountCodeCreation
+	var r1 []goshopify.PriceRuleDiscountCode
+	return r0, r1, m.unexpected("DiscountCodeService", "WaitForBatch")
+}
+
+// DraftOrderService is a mock of goshopify.DraftOrderService
+type DraftOrderService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.DraftOrder, error)
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, interface{}) (int, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.DraftOrder, error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.DraftOrder) (*goshopify.DraftOrder, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, goshopify.DraftOrder) (*goshopify.DraftOrder, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64) error
+
+	// InvoiceFunc is called by Invoice if set
+	InvoiceFunc func(context.Context, int64, goshopify.DraftOrderInvoice) (*goshopify.DraftOrderInvoice, error)
+
+	// CompleteFunc is called by Complete if set
+	CompleteFunc func(context.Context, int64, bool) (*goshopify.DraftOrder, error)
+
+	// ListMetafieldsFunc is called by ListMetafields if set
+	ListMetafieldsFunc func(context.Context, int64, interface{}) ([]goshopify.Metafield, error)
+
+	// CountMetafieldsFunc is called by CountMetafields if set
+	CountMetafieldsFunc func(context.Context, int64, interface{}) (int, error)
+
+	// GetMetafieldFunc is called by GetMetafield if set
+	GetMetafieldFunc func(context.Context, int64, int64, interface{}) (*goshopify.Metafield, error)
+
+	// CreateMetafieldFunc is called by CreateMetafield if set
+	CreateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// UpdateMetafieldFunc is called by UpdateMetafield if set
+	UpdateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// DeleteMetafieldFunc is called by DeleteMetafield if set
+	DeleteMetafieldFunc func(context.Context, int64, int64) error
+}
+
+var _ goshopify.DraftOrderService = (*DraftOrderService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *DraftOrderService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.DraftOrder, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.DraftOrder
+	return r0, m.unexpected("DraftOrderService", "List")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *DraftOrderService) Count(arg0 context.Context, arg1 interface{}) (int, error) {
+	m.record("Count", arg0, arg1)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1)
+	}
+	var r0 int
+	return r0, m.unexpected("DraftOrderService", "Count")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *DraftOrderService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.DraftOrder, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.DraftOrder
+	return r0, m.unexpected("DraftOrderService", "Get")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *DraftOrderService) Create(arg0 context.Context, arg1 goshopify.DraftOrder) (*goshopify.DraftOrder, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.DraftOrder
+	return r0, m.unexpected("DraftOrderService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *DraftOrderService) Update(arg0 context.Context, arg1 goshopify.DraftOrder) (*goshopify.DraftOrder, error) {
+	m.record("Update", arg0, arg1)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.DraftOrder
+	return r0, m.unexpected("DraftOrderService", "Update")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *DraftOrderService) Delete(arg0 context.Context, arg1 int64) error {
+	m.record("Delete", arg0, arg1)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1)
+	}
+	return m.unexpected("DraftOrderService", "Delete")
+}
+
+// Invoice records the call and returns the results of InvoiceFunc
+func (m *DraftOrderService) Invoice(arg0 context.Context, arg1 int64, arg2 goshopify.DraftOrderInvoice) (*goshopify.DraftOrderInvoice, error) {
+	m.record("Invoice", arg0, arg1, arg2)
+	if m.InvoiceFunc != nil {
+		return m.InvoiceFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.DraftOrderInvoice
+	return r0, m.unexpected("DraftOrderService", "Invoice")
+}
+
+// Complete records the call and returns the results of CompleteFunc
+func (m *DraftOrderService) Complete(arg0 context.Context, arg1 int64, arg2 bool) (*goshopify.DraftOrder, error) {
+	m.record("Complete", arg0, arg1, arg2)
+	if m.CompleteFunc != nil {
+		return m.CompleteFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.DraftOrder
+	return r0, m.unexpected("DraftOrderService", "Complete")
+}
+
+// ListMetafields records the call and returns the results of ListMetafieldsFunc
+func (m *DraftOrderService) ListMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Metafield, error) {
+	m.record("ListMetafields", arg0, arg1, arg2)
+	if m.ListMetafieldsFunc != nil {
+		return m.ListMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Metafield
+	return r0, m.unexpected("DraftOrderService", "ListMetafields")
+}
+
+// CountMetafields records the call and returns the results of CountMetafieldsFunc
+func (m *DraftOrderService) CountMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) (int, error) {
+	m.record("CountMetafields", arg0, arg1, arg2)
+	if m.CountMetafieldsFunc != nil {
+		return m.CountMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 int
+	return r0, m.unexpected("DraftOrderService", "CountMetafields")
+}
+
+// GetMetafield records the call and returns the results of GetMetafieldFunc
+func (m *DraftOrderService) GetMetafield(arg0 context.Context, arg1 int64, arg2 int64, arg3 interface{}) (*goshopify.Metafield, error) {
+	m.record("GetMetafield", arg0, arg1, arg2, arg3)
+	if m.GetMetafieldFunc != nil {
+		return m.GetMetafieldFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("DraftOrderService", "GetMetafield")
+}
+
+// CreateMetafield records the call and returns the results of CreateMetafieldFunc
+func (m *DraftOrderService) CreateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("CreateMetafield", arg0, arg1, arg2)
+	if m.CreateMetafieldFunc != nil {
+		return m.CreateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("DraftOrderService", "CreateMetafield")
+}
+
+// UpdateMetafield records the call and returns the results of UpdateMetafieldFunc
+func (m *DraftOrderService) UpdateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("UpdateMetafield", arg0, arg1, arg2)
+	if m.UpdateMetafieldFunc != nil {
+		return m.UpdateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("DraftOrderService", "UpdateMetafield")
+}
+
+// DeleteMetafield records the call and returns the results of DeleteMetafieldFunc
+func (m *DraftOrderService) DeleteMetafield(arg0 context.Context, arg1 int64, arg2 int64) error {
+	m.record("DeleteMetafield", arg0, arg1, arg2)
+	if m.DeleteMetafieldFunc != nil {
+		return m.DeleteMetafieldFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("DraftOrderService", "DeleteMetafield")
+}
+
+// FulfillmentService is a mock of goshopify.FulfillmentService
+type FulfillmentService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.Fulfillment, error)
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, interface{}) (int, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.Fulfillment, error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.Fulfillment) (*goshopify.Fulfillment, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, goshopify.Fulfillment) (*goshopify.Fulfillment, error)
+
+	// CompleteFunc is called by Complete if set
+	CompleteFunc func(context.Context, int64) (*goshopify.Fulfillment, error)
+
+	// TransitionFunc is called by Transition if set
+	TransitionFunc func(context.Context, int64) (*goshopify.Fulfillment, error)
+
+	// CancelFunc is called by Cancel if set
+	CancelFunc func(context.Context, int64) (*goshopify.Fulfillment, error)
+}
+
+var _ goshopify.FulfillmentService = (*FulfillmentService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *FulfillmentService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.Fulfillment, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.Fulfillment
+	return r0, m.unexpected("FulfillmentService", "List")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *FulfillmentService) Count(arg0 context.Context, arg1 interface{}) (int, error) {
+	m.record("Count", arg0, arg1)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1)
+	}
+	var r0 int
+	return r0, m.unexpected("FulfillmentService", "Count")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *FulfillmentService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.Fulfillment, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("FulfillmentService", "Get")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *FulfillmentService) Create(arg0 context.Context, arg1 goshopify.Fulfillment) (*goshopify.Fulfillment, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("FulfillmentService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *FulfillmentService) Update(arg0 context.Context, arg1 goshopify.Fulfillment) (*goshopify.Fulfillment, error) {
+	m.record("Update", arg0, arg1)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("FulfillmentService", "Update")
+}
+
+// Complete records the call and returns the results of CompleteFunc
+func (m *FulfillmentService) Complete(arg0 context.Context, arg1 int64) (*goshopify.Fulfillment, error) {
+	m.record("Complete", arg0, arg1)
+	if m.CompleteFunc != nil {
+		return m.CompleteFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("FulfillmentService", "Complete")
+}
+
+// Transition records the call and returns the results of TransitionFunc
+func (m *FulfillmentService) Transition(arg0 context.Context, arg1 int64) (*goshopify.Fulfillment, error) {
+	m.record("Transition", arg0, arg1)
+	if m.TransitionFunc != nil {
+		return m.TransitionFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("FulfillmentService", "Transition")
+}
+
+// Cancel records the call and returns the results of CancelFunc
+func (m *FulfillmentService) Cancel(arg0 context.Context, arg1 int64) (*goshopify.Fulfillment, error) {
+	m.record("Cancel", arg0, arg1)
+	if m.CancelFunc != nil {
+		return m.CancelFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("FulfillmentService", "Cancel")
+}
+
+// FulfillmentsService is a mock of goshopify.FulfillmentsService
+type FulfillmentsService struct {
+	Mock
+
+	// ListFulfillmentsFunc is called by ListFulfillments if set
+	ListFulfillmentsFunc func(context.Context, int64, interface{}) ([]goshopify.Fulfillment, error)
+
+	// CountFulfillmentsFunc is called by CountFulfillments if set
+	CountFulfillmentsFunc func(context.Context, int64, interface{}) (int, error)
+
+	// GetFulfillmentFunc is called by GetFulfillment if set
+	GetFulfillmentFunc func(context.Context, int64, int64, interface{}) (*goshopify.Fulfillment, error)
+
+	// CreateFulfillmentFunc is called by CreateFulfillment if set
+	CreateFulfillmentFunc func(context.Context, int64, goshopify.Fulfillment) (*goshopify.Fulfillment, error)
+
+	// UpdateFulfillmentFunc is called by UpdateFulfillment if set
+	UpdateFulfillmentFunc func(context.Context, int64, goshopify.Fulfillment) (*goshopify.Fulfillment, error)
+
+	// CompleteFulfillmentFunc is called by CompleteFulfillment if set
+	CompleteFulfillmentFunc func(context.Context, int64, int64) (*goshopify.Fulfillment, error)
+
+	// TransitionFulfillmentFunc is called by TransitionFulfillment if set
+	TransitionFulfillmentFunc func(context.Context, int64, int64) (*goshopify.Fulfillment, error)
+
+	// CancelFulfillmentFunc is called by CancelFulfillment if set
+	CancelFulfillmentFunc func(context.Context, int64, int64) (*goshopify.Fulfillment, error)
+}
+
+var _ goshopify.FulfillmentsService = (*FulfillmentsService)(nil)
+
+// ListFulfillments records the call and returns the results of ListFulfillmentsFunc
+func (m *FulfillmentsService) ListFulfillments(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Fulfillment, error) {
+	m.record("ListFulfillments", arg0, arg1, arg2)
+	if m.ListFulfillmentsFunc != nil {
+		return m.ListFulfillmentsFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Fulfillment
+	return r0, m.unexpected("FulfillmentsService", "ListFulfillments")
+}
+
+// CountFulfillments records the call and returns the results of CountFulfillmentsFunc
+func (m *FulfillmentsService) CountFulfillments(arg0 context.Context, arg1 int64, arg2 interface{}) (int, error) {
+	m.record("CountFulfillments", arg0, arg1, arg2)
+	if m.CountFulfillmentsFunc != nil {
+		return m.CountFulfillmentsFunc(arg0, arg1, arg2)
+	}
+	var r0 int
+	return r0, m.unexpected("FulfillmentsService", "CountFulfillments")
+}
+
+// GetFulfillment records the call and returns the results of GetFulfillmentFunc
+func (m *FulfillmentsService) GetFulfillment(arg0 context.Context, arg1 int64, arg2 int64, arg3 interface{}) (*goshopify.Fulfillment, error) {
+	m.record("GetFulfillment", arg0, arg1, arg2, arg3)
+	if m.GetFulfillmentFunc != nil {
+		return m.GetFulfillmentFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("FulfillmentsService", "GetFulfillment")
+}
+
+// CreateFulfillment records the call and returns the results of CreateFulfillmentFunc
+func (m *FulfillmentsService) CreateFulfillment(arg0 context.Context, arg1 int64, arg2 goshopify.Fulfillment) (*goshopify.Fulfillment, error) {
+	m.record("CreateFulfillment", arg0, arg1, arg2)
+	if m.CreateFulfillmentFunc != nil {
+		return m.CreateFulfillmentFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("FulfillmentsService", "CreateFulfillment")
+}
+
+// UpdateFulfillment records the call and returns the results of UpdateFulfillmentFunc
+func (m *FulfillmentsService) UpdateFulfillment(arg0 context.Context, arg1 int64, arg2 goshopify.Fulfillment) (*goshopify.Fulfillment, error) {
+	m.record("UpdateFulfillment", arg0, arg1, arg2)
+	if m.UpdateFulfillmentFunc != nil {
+		return m.UpdateFulfillmentFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("FulfillmentsService", "UpdateFulfillment")
+}
+
+// CompleteFulfillment records the call and returns the results of CompleteFulfillmentFunc
+func (m *FulfillmentsService) CompleteFulfillment(arg0 context.Context, arg1 int64, arg2 int64) (*goshopify.Fulfillment, error) {
+	m.record("CompleteFulfillment", arg0, arg1, arg2)
+	if m.CompleteFulfillmentFunc != nil {
+		return m.CompleteFulfillmentFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("FulfillmentsService", "CompleteFulfillment")
+}
+
+// TransitionFulfillment records the call and returns the results of TransitionFulfillmentFunc
+func (m *FulfillmentsService) TransitionFulfillment(arg0 context.Context, arg1 int64, arg2 int64) (*goshopify.Fulfillment, error) {
+	m.record("TransitionFulfillment", arg0, arg1, arg2)
+	if m.TransitionFulfillmentFunc != nil {
+		return m.TransitionFulfillmentFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("FulfillmentsService", "TransitionFulfillment")
+}
+
+// CancelFulfillment records the call and returns the results of CancelFulfillmentFunc
+func (m *FulfillmentsService) CancelFulfillment(arg0 context.Context, arg1 int64, arg2 int64) (*goshopify.Fulfillment, error) {
+	m.record("CancelFulfillment", arg0, arg1, arg2)
+	if m.CancelFulfillmentFunc != nil {
+		return m.CancelFulfillmentFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("FulfillmentsService", "CancelFulfillment")
+}
+
+// ImageService is a mock of goshopify.ImageService
+type ImageService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, int64, interface{}) ([]goshopify.Image, error)
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, int64, interface{}) (int, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, int64, interface{}) (*goshopify.Image, error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, int64, goshopify.Image) (*goshopify.Image, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, int64, goshopify.Image) (*goshopify.Image, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64, int64) error
+}
+
+var _ goshopify.ImageService = (*ImageService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *ImageService) List(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Image, error) {
+	m.record("List", arg0, arg1, arg2)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Image
+	return r0, m.unexpected("ImageService", "List")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *ImageService) Count(arg0 context.Context, arg1 int64, arg2 interface{}) (int, error) {
+	m.record("Count", arg0, arg1, arg2)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1, arg2)
+	}
+	var r0 int
+	return r0, m.unexpected("ImageService", "Count")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *ImageService) Get(arg0 context.Context, arg1 int64, arg2 int64, arg3 interface{}) (*goshopify.Image, error) {
+	m.record("Get", arg0, arg1, arg2, arg3)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.Image
+	return r0, m.unexpected("ImageService", "Get")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *ImageService) Create(arg0 context.Context, arg1 int64, arg2 goshopify.Image) (*goshopify.Image, error) {
+	m.record("Create", arg0, arg1, arg2)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Image
+	return r0, m.unexpected("ImageService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *ImageService) Update(arg0 context.Context, arg1 int64, arg2 goshopify.Image) (*goshopify.Image, error) {
+	m.record("Update", arg0, arg1, arg2)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Image
+	return r0, m.unexpected("ImageService", "Update")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *ImageService) Delete(arg0 context.Context, arg1 int64, arg2 int64) error {
+	m.record("Delete", arg0, arg1, arg2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("ImageService", "Delete")
+}
+
+// InventoryItemService is a mock of goshopify.InventoryItemService
+type InventoryItemService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.InventoryItem, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.InventoryItem, error)
+
+	// GetManyFunc is called by GetMany if set
+	GetManyFunc func(context.Context, []int64) (*goshopify.GetManyResult[goshopify.InventoryItem], error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, goshopify.InventoryItem) (*goshopify.InventoryItem, error)
+}
+
+var _ goshopify.InventoryItemService = (*InventoryItemService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *InventoryItemService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.InventoryItem, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.InventoryItem
+	return r0, m.unexpected("InventoryItemService", "List")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *InventoryItemService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.InventoryItem, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.InventoryItem
+	return r0, m.unexpected("InventoryItemService", "Get")
+}
+
+// GetMany records the call and returns the results of GetManyFunc
+func (m *InventoryItemService) GetMany(arg0 context.Context, arg1 []int64) (*goshopify.GetManyResult[goshopify.InventoryItem], error) {
+	m.record("GetMany", arg0, arg1)
+	if m.GetManyFunc != nil {
+		return m.GetManyFunc(arg0, arg1)
+	}
+	var r0 *goshopify.GetManyResult[goshopify.InventoryItem]
+	return r0, m.unexpected("InventoryItemService", "GetMany")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *InventoryItemService) Update(arg0 context.Context, arg1 goshopify.InventoryItem) (*goshopify.InventoryItem, error) {
+	m.record("Update", arg0, arg1)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.InventoryItem
+	return r0, m.unexpected("InventoryItemService", "Update")
+}
+
+// LocationService is a mock of goshopify.LocationService
+type LocationService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.Location, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.Location, error)
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, interface{}) (int, error)
+}
+
+var _ goshopify.LocationService = (*LocationService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *LocationService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.Location, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.Location
+	return r0, m.unexpected("LocationService", "List")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *LocationService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.Location, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Location
+	return r0, m.unexpected("LocationService", "Get")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *LocationService) Count(arg0 context.Context, arg1 interface{}) (int, error) {
+	m.record("Count", arg0, arg1)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1)
+	}
+	var r0 int
+	return r0, m.unexpected("LocationService", "Count")
+}
+
+// MetafieldService is a mock of goshopify.MetafieldService
+type MetafieldService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.Metafield, error)
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, interface{}) (int, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.Metafield, error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64) error
+}
+
+var _ goshopify.MetafieldService = (*MetafieldService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *MetafieldService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.Metafield, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.Metafield
+	return r0, m.unexpected("MetafieldService", "List")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *MetafieldService) Count(arg0 context.Context, arg1 interface{}) (int, error) {
+	m.record("Count", arg0, arg1)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1)
+	}
+	var r0 int
+	return r0, m.unexpected("MetafieldService", "Count")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *MetafieldService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.Metafield, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("MetafieldService", "Get")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *MetafieldService) Create(arg0 context.Context, arg1 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("MetafieldService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *MetafieldService) Update(arg0 context.Context, arg1 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("Update", arg0, arg1)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("MetafieldService", "Update")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *MetafieldService) Delete(arg0 context.Context, arg1 int64) error {
+	m.record("Delete", arg0, arg1)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1)
+	}
+	return m.unexpected("MetafieldService", "Delete")
+}
+
+// MetafieldsService is a mock of goshopify.MetafieldsService
+type MetafieldsService struct {
+	Mock
+
+	// ListMetafieldsFunc is called by ListMetafields if set
+	ListMetafieldsFunc func(context.Context, int64, interface{}) ([]goshopify.Metafield, error)
+
+	// CountMetafieldsFunc is called by CountMetafields if set
+	CountMetafieldsFunc func(context.Context, int64, interface{}) (int, error)
+
+	// GetMetafieldFunc is called by GetMetafield if set
+	GetMetafieldFunc func(context.Context, int64, int64, interface{}) (*goshopify.Metafield, error)
+
+	// CreateMetafieldFunc is called by CreateMetafield if set
+	CreateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// UpdateMetafieldFunc is called by UpdateMetafield if set
+	UpdateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// DeleteMetafieldFunc is called by DeleteMetafield if set
+	DeleteMetafieldFunc func(context.Context, int64, int64) error
+}
+
+var _ goshopify.MetafieldsService = (*MetafieldsService)(nil)
+
+// ListMetafields records the call and returns the results of ListMetafieldsFunc
+func (m *MetafieldsService) ListMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Metafield, error) {
+	m.record("ListMetafields", arg0, arg1, arg2)
+	if m.ListMetafieldsFunc != nil {
+		return m.ListMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Metafield
+	return r0, m.unexpected("MetafieldsService", "ListMetafields")
+}
+
+// CountMetafields records the call and returns the results of CountMetafieldsFunc
+func (m *MetafieldsService) CountMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) (int, error) {
+	m.record("CountMetafields", arg0, arg1, arg2)
+	if m.CountMetafieldsFunc != nil {
+		return m.CountMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 int
+	return r0, m.unexpected("MetafieldsService", "CountMetafields")
+}
+
+// GetMetafield records the call and returns the results of GetMetafieldFunc
+func (m *MetafieldsService) GetMetafield(arg0 context.Context, arg1 int64, arg2 int64, arg3 interface{}) (*goshopify.Metafield, error) {
+	m.record("GetMetafield", arg0, arg1, arg2, arg3)
+	if m.GetMetafieldFunc != nil {
+		return m.GetMetafieldFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("MetafieldsService", "GetMetafield")
+}
+
+// CreateMetafield records the call and returns the results of CreateMetafieldFunc
+func (m *MetafieldsService) CreateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("CreateMetafield", arg0, arg1, arg2)
+	if m.CreateMetafieldFunc != nil {
+		return m.CreateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("MetafieldsService", "CreateMetafield")
+}
+
+// UpdateMetafield records the call and returns the results of UpdateMetafieldFunc
+func (m *MetafieldsService) UpdateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("UpdateMetafield", arg0, arg1, arg2)
+	if m.UpdateMetafieldFunc != nil {
+		return m.UpdateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("MetafieldsService", "UpdateMetafield")
+}
+
+// DeleteMetafield records the call and returns the results of DeleteMetafieldFunc
+func (m *MetafieldsService) DeleteMetafield(arg0 context.Context, arg1 int64, arg2 int64) error {
+	m.record("DeleteMetafield", arg0, arg1, arg2)
+	if m.DeleteMetafieldFunc != nil {
+		return m.DeleteMetafieldFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("MetafieldsService", "DeleteMetafield")
+}
+
+// OrderService is a mock of goshopify.OrderService
+type OrderService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.Order, error)
+
+	// ListWithPaginationFunc is called by ListWithPagination if set
+	ListWithPaginationFunc func(context.Context, interface{}) ([]goshopify.Order, *goshopify.Pagination, error)
+
+	// StreamOrdersFunc is called by StreamOrders if set
+	StreamOrdersFunc func(context.Context, interface{}, func(goshopify.Order) error) error
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, interface{}) (int, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.Order, error)
+
+	// GetManyFunc is called by GetMany if set
+	GetManyFunc func(context.Context, []int64) (*goshopify.GetManyResult[goshopify.Order], error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.Order) (*goshopify.Order, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, goshopify.Order) (*goshopify.Order, error)
+
+	// PatchFunc is called by Patch if set
+	PatchFunc func(context.Context, int64, *goshopify.Patch[goshopify.Order]) (*goshopify.Order, error)
+
+	// CancelFunc is called by Cancel if set
+	CancelFunc func(context.Context, int64, interface{}) (*goshopify.Order, error)
+
+	// CloseFunc is called by Close if set
+	CloseFunc func(context.Context, int64) (*goshopify.Order, error)
+
+	// OpenFunc is called by Open if set
+	OpenFunc func(context.Context, int64) (*goshopify.Order, error)
+
+	// ListMetafieldsFunc is called by ListMetafields if set
+	ListMetafieldsFunc func(context.Context, int64, interface{}) ([]goshopify.Metafield, error)
+
+	// CountMetafieldsFunc is called by CountMetafields if set
+	CountMetafieldsFunc func(context.Context, int64, interface{}) (int, error)
+
+	// GetMetafieldFunc is called by GetMetafield if set
+	GetMetafieldFunc func(context.Context, int64, int64, interface{}) (*goshopify.Metafield, error)
+
+	// CreateMetafieldFunc is called by CreateMetafield if set
+	CreateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// UpdateMetafieldFunc is called by UpdateMetafield if set
+	UpdateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// DeleteMetafieldFunc is called by DeleteMetafield if set
+	DeleteMetafieldFunc func(context.Context, int64, int64) error
+
+	// ListFulfillmentsFunc is called by ListFulfillments if set
+	ListFulfillmentsFunc func(context.Context, int64, interface{}) ([]goshopify.Fulfillment, error)
+
+	// CountFulfillmentsFunc is called by CountFulfillments if set
+	CountFulfillmentsFunc func(context.Context, int64, interface{}) (int, error)
+
+	// GetFulfillmentFunc is called by GetFulfillment if set
+	GetFulfillmentFunc func(context.Context, int64, int64, interface{}) (*goshopify.Fulfillment, error)
+
+	// CreateFulfillmentFunc is called by CreateFulfillment if set
+	CreateFulfillmentFunc func(context.Context, int64, goshopify.Fulfillment) (*goshopify.Fulfillment, error)
+
+	// UpdateFulfillmentFunc is called by UpdateFulfillment if set
+	UpdateFulfillmentFunc func(context.Context, int64, goshopify.Fulfillment) (*goshopify.Fulfillment, error)
+
+	// CompleteFulfillmentFunc is called by CompleteFulfillment if set
+	CompleteFulfillmentFunc func(context.Context, int64, int64) (*goshopify.Fulfillment, error)
+
+	// TransitionFulfillmentFunc is called by TransitionFulfillment if set
+	TransitionFulfillmentFunc func(context.Context, int64, int64) (*goshopify.Fulfillment, error)
+
+	// CancelFulfillmentFunc is called by CancelFulfillment if set
+	CancelFulfillmentFunc func(context.Context, int64, int64) (*goshopify.Fulfillment, error)
+}
+
+var _ goshopify.OrderService = (*OrderService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *OrderService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.Order, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.Order
+	return r0, m.unexpected("OrderService", "List")
+}
+
+// ListWithPagination records the call and returns the results of ListWithPaginationFunc
+func (m *OrderService) ListWithPagination(arg0 context.Context, arg1 interface{}) ([]goshopify.Order, *goshopify.Pagination, error) {
+	m.record("ListWithPagination", arg0, arg1)
+	if m.ListWithPaginationFunc != nil {
+		return m.ListWithPaginationFunc(arg0, arg1)
+	}
+	var r0 []goshopify.Order
+	var r1 *goshopify.Pagination
+	return r0, r1, m.unexpected("OrderService", "ListWithPagination")
+}
+
+// StreamOrders records the call and returns the results of StreamOrdersFunc
+func (m *OrderService) StreamOrders(arg0 context.Context, arg1 interface{}, arg2 func(goshopify.Order) error) error {
+	m.record("StreamOrders", arg0, arg1, arg2)
+	if m.StreamOrdersFunc != nil {
+		return m.StreamOrdersFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("OrderService", "StreamOrders")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *OrderService) Count(arg0 context.Context, arg1 interface{}) (int, error) {
+	m.record("Count", arg0, arg1)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1)
+	}
+	var r0 int
+	return r0, m.unexpected("OrderService", "Count")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *OrderService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.Order, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Order
+	return r0, m.unexpected("OrderService", "Get")
+}
+
+// GetMany records the call and returns the results of GetManyFunc
+func (m *OrderService) GetMany(arg0 context.Context, arg1 []int64) (*goshopify.GetManyResult[goshopify.Order], error) {
+	m.record("GetMany", arg0, arg1)
+	if m.GetManyFunc != nil {
+		return m.GetManyFunc(arg0, arg1)
+	}
+	var r0 *goshopify.GetManyResult[goshopify.Order]
+	return r0, m.unexpected("OrderService", "GetMany")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *OrderService) Create(arg0 context.Context, arg1 goshopify.Order) (*goshopify.Order, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Order
+	return r0, m.unexpected("OrderService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *OrderService) Update(arg0 context.Context, arg1 goshopify.Order) (*goshopify.Order, error) {
+	m.record("Update", arg0, arg1)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Order
+	return r0, m.unexpected("OrderService", "Update")
+}
+
+// Patch records the call and returns the results of PatchFunc
+func (m *OrderService) Patch(arg0 context.Context, arg1 int64, arg2 *goshopify.Patch[goshopify.Order]) (*goshopify.Order, error) {
+	m.record("Patch", arg0, arg1, arg2)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Order
+	return r0, m.unexpected("OrderService", "Patch")
+}
+
+// Cancel records the call and returns the results of CancelFunc
+func (m *OrderService) Cancel(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.Order, error) {
+	m.record("Cancel", arg0, arg1, arg2)
+	if m.CancelFunc != nil {
+		return m.CancelFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Order
+	return r0, m.unexpected("OrderService", "Cancel")
+}
+
+// Close records the call and returns the results of CloseFunc
+func (m *OrderService) Close(arg0 context.Context, arg1 int64) (*goshopify.Order, error) {
+	m.record("Close", arg0, arg1)
+	if m.CloseFunc != nil {
+		return m.CloseFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Order
+	return r0, m.unexpected("OrderService", "Close")
+}
+
+// Open records the call and returns the results of OpenFunc
+func (m *OrderService) Open(arg0 context.Context, arg1 int64) (*goshopify.Order, error) {
+	m.record("Open", arg0, arg1)
+	if m.OpenFunc != nil {
+		return m.OpenFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Order
+	return r0, m.unexpected("OrderService", "Open")
+}
+
+// ListMetafields records the call and returns the results of ListMetafieldsFunc
+func (m *OrderService) ListMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Metafield, error) {
+	m.record("ListMetafields", arg0, arg1, arg2)
+	if m.ListMetafieldsFunc != nil {
+		return m.ListMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Metafield
+	return r0, m.unexpected("OrderService", "ListMetafields")
+}
+
+// CountMetafields records the call and returns the results of CountMetafieldsFunc
+func (m *OrderService) CountMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) (int, error) {
+	m.record("CountMetafields", arg0, arg1, arg2)
+	if m.CountMetafieldsFunc != nil {
+		return m.CountMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 int
+	return r0, m.unexpected("OrderService", "CountMetafields")
+}
+
+// GetMetafield records the call and returns the results of GetMetafieldFunc
+func (m *OrderService) GetMetafield(arg0 context.Context, arg1 int64, arg2 int64, arg3 interface{}) (*goshopify.Metafield, error) {
+	m.record("GetMetafield", arg0, arg1, arg2, arg3)
+	if m.GetMetafieldFunc != nil {
+		return m.GetMetafieldFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("OrderService", "GetMetafield")
+}
+
+// CreateMetafield records the call and returns the results of CreateMetafieldFunc
+func (m *OrderService) CreateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("CreateMetafield", arg0, arg1, arg2)
+	if m.CreateMetafieldFunc != nil {
+		return m.CreateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("OrderService", "CreateMetafield")
+}
+
+// UpdateMetafield records the call and returns the results of UpdateMetafieldFunc
+func (m *OrderService) UpdateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("UpdateMetafield", arg0, arg1, arg2)
+	if m.UpdateMetafieldFunc != nil {
+		return m.UpdateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("OrderService", "UpdateMetafield")
+}
+
+// DeleteMetafield records the call and returns the results of DeleteMetafieldFunc
+func (m *OrderService) DeleteMetafield(arg0 context.Context, arg1 int64, arg2 int64) error {
+	m.record("DeleteMetafield", arg0, arg1, arg2)
+	if m.DeleteMetafieldFunc != nil {
+		return m.DeleteMetafieldFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("OrderService", "DeleteMetafield")
+}
+
+// ListFulfillments records the call and returns the results of ListFulfillmentsFunc
+func (m *OrderService) ListFulfillments(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Fulfillment, error) {
+	m.record("ListFulfillments", arg0, arg1, arg2)
+	if m.ListFulfillmentsFunc != nil {
+		return m.ListFulfillmentsFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Fulfillment
+	return r0, m.unexpected("OrderService", "ListFulfillments")
+}
+
+// CountFulfillments records the call and returns the results of CountFulfillmentsFunc
+func (m *OrderService) CountFulfillments(arg0 context.Context, arg1 int64, arg2 interface{}) (int, error) {
+	m.record("CountFulfillments", arg0, arg1, arg2)
+	if m.CountFulfillmentsFunc != nil {
+		return m.CountFulfillmentsFunc(arg0, arg1, arg2)
+	}
+	var r0 int
+	return r0, m.unexpected("OrderService", "CountFulfillments")
+}
+
+// GetFulfillment records the call and returns the results of GetFulfillmentFunc
+func (m *OrderService) GetFulfillment(arg0 context.Context, arg1 int64, arg2 int64, arg3 interface{}) (*goshopify.Fulfillment, error) {
+	m.record("GetFulfillment", arg0, arg1, arg2, arg3)
+	if m.GetFulfillmentFunc != nil {
+		return m.GetFulfillmentFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("OrderService", "GetFulfillment")
+}
+
+// CreateFulfillment records the call and returns the results of CreateFulfillmentFunc
+func (m *OrderService) CreateFulfillment(arg0 context.Context, arg1 int64, arg2 goshopify.Fulfillment) (*goshopify.Fulfillment, error) {
+	m.record("CreateFulfillment", arg0, arg1, arg2)
+	if m.CreateFulfillmentFunc != nil {
+		return m.CreateFulfillmentFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("OrderService", "CreateFulfillment")
+}
+
+// UpdateFulfillment records the call and returns the results of UpdateFulfillmentFunc
+func (m *OrderService) UpdateFulfillment(arg0 context.Context, arg1 int64, arg2 goshopify.Fulfillment) (*goshopify.Fulfillment, error) {
+	m.record("UpdateFulfillment", arg0, arg1, arg2)
+	if m.UpdateFulfillmentFunc != nil {
+		return m.UpdateFulfillmentFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("OrderService", "UpdateFulfillment")
+}
+
+// CompleteFulfillment records the call and returns the results of CompleteFulfillmentFunc
+func (m *OrderService) CompleteFulfillment(arg0 context.Context, arg1 int64, arg2 int64) (*goshopify.Fulfillment, error) {
+	m.record("CompleteFulfillment", arg0, arg1, arg2)
+	if m.CompleteFulfillmentFunc != nil {
+		return m.CompleteFulfillmentFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("OrderService", "CompleteFulfillment")
+}
+
+// TransitionFulfillment records the call and returns the results of TransitionFulfillmentFunc
+func (m *OrderService) TransitionFulfillment(arg0 context.Context, arg1 int64, arg2 int64) (*goshopify.Fulfillment, error) {
+	m.record("TransitionFulfillment", arg0, arg1, arg2)
+	if m.TransitionFulfillmentFunc != nil {
+		return m.TransitionFulfillmentFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("OrderService", "TransitionFulfillment")
+}
+
+// CancelFulfillment records the call and returns the results of CancelFulfillmentFunc
+func (m *OrderService) CancelFulfillment(arg0 context.Context, arg1 int64, arg2 int64) (*goshopify.Fulfillment, error) {
+	m.record("CancelFulfillment", arg0, arg1, arg2)
+	if m.CancelFulfillmentFunc != nil {
+		return m.CancelFulfillmentFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Fulfillment
+	return r0, m.unexpected("OrderService", "CancelFulfillment")
+}
+
+// PageService is a mock of goshopify.PageService
+type PageService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.Page, error)
+
+	// GetBySinceIdFunc is called by GetBySinceId if set
+	GetBySinceIdFunc func(context.Context, int64, int64, interface{}) ([]goshopify.Page, error)
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, interface{}) (int, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.Page, error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.Page) (*goshopify.Page, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, goshopify.Page) (*goshopify.Page, error)
+
+	// PatchFunc is called by Patch if set
+	PatchFunc func(context.Context, int64, *goshopify.Patch[goshopify.Page]) (*goshopify.Page, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64) error
+
+	// ListMetafieldsFunc is called by ListMetafields if set
+	ListMetafieldsFunc func(context.Context, int64, interface{}) ([]goshopify.Metafield, error)
+
+	// CountMetafieldsFunc is called by CountMetafields if set
+	CountMetafieldsFunc func(context.Context, int64, interface{}) (int, error)
+
+	// GetMetafieldFunc is called by GetMetafield if set
+	GetMetafieldFunc func(context.Context, int64, int64, interface{}) (*goshopify.Metafield, error)
+
+	// CreateMetafieldFunc is called by CreateMetafield if set
+	CreateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// UpdateMetafieldFunc is called by UpdateMetafield if set
+	UpdateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// DeleteMetafieldFunc is called by DeleteMetafield if set
+	DeleteMetafieldFunc func(context.Context, int64, int64) error
+}
+
+var _ goshopify.PageService = (*PageService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *PageService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.Page, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.Page
+	return r0, m.unexpected("PageService", "List")
+}
+
+// GetBySinceId records the call and returns the results of GetBySinceIdFunc
+func (m *PageService) GetBySinceId(arg0 context.Context, arg1 int64, arg2 int64, arg3 interface{}) ([]goshopify.Page, error) {
+	m.record("GetBySinceId", arg0, arg1, arg2, arg3)
+	if m.GetBySinceIdFunc != nil {
+		return m.GetBySinceIdFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 []goshopify.Page
+	return r0, m.unexpected("PageService", "GetBySinceId")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *PageService) Count(arg0 context.Context, arg1 interface{}) (int, error) {
+	m.record("Count", arg0, arg1)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1)
+	}
+	var r0 int
+	return r0, m.unexpected("PageService", "Count")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *PageService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.Page, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Page
+	return r0, m.unexpected("PageService", "Get")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *PageService) Create(arg0 context.Context, arg1 goshopify.Page) (*goshopify.Page, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Page
+	return r0, m.unexpected("PageService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *PageService) Update(arg0 context.Context, arg1 goshopify.Page) (*goshopify.Page, error) {
+	m.record("Update", arg0, arg1)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Page
+	return r0, m.unexpected("PageService", "Update")
+}
+
+// Patch records the call and returns the results of PatchFunc
+func (m *PageService) Patch(arg0 context.Context, arg1 int64, arg2 *goshopify.Patch[goshopify.Page]) (*goshopify.Page, error) {
+	m.record("Patch", arg0, arg1, arg2)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Page
+	return r0, m.unexpected("PageService", "Patch")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *PageService) Delete(arg0 context.Context, arg1 int64) error {
+	m.record("Delete", arg0, arg1)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1)
+	}
+	return m.unexpected("PageService", "Delete")
+}
+
+// ListMetafields records the call and returns the results of ListMetafieldsFunc
+func (m *PageService) ListMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Metafield, error) {
+	m.record("ListMetafields", arg0, arg1, arg2)
+	if m.ListMetafieldsFunc != nil {
+		return m.ListMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Metafield
+	return r0, m.unexpected("PageService", "ListMetafields")
+}
+
+// CountMetafields records the call and returns the results of CountMetafieldsFunc
+func (m *PageService) CountMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) (int, error) {
+	m.record("CountMetafields", arg0, arg1, arg2)
+	if m.CountMetafieldsFunc != nil {
+		return m.CountMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 int
+	return r0, m.unexpected("PageService", "CountMetafields")
+}
+
+// GetMetafield records the call and returns the results of GetMetafieldFunc
+func (m *PageService) GetMetafield(arg0 context.Context, arg1 int64, arg2 int64, arg3 interface{}) (*goshopify.Metafield, error) {
+	m.record("GetMetafield", arg0, arg1, arg2, arg3)
+	if m.GetMetafieldFunc != nil {
+		return m.GetMetafieldFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("PageService", "GetMetafield")
+}
+
+// CreateMetafield records the call and returns the results of CreateMetafieldFunc
+func (m *PageService) CreateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("CreateMetafield", arg0, arg1, arg2)
+	if m.CreateMetafieldFunc != nil {
+		return m.CreateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("PageService", "CreateMetafield")
+}
+
+// UpdateMetafield records the call and returns the results of UpdateMetafieldFunc
+func (m *PageService) UpdateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("UpdateMetafield", arg0, arg1, arg2)
+	if m.UpdateMetafieldFunc != nil {
+		return m.UpdateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("PageService", "UpdateMetafield")
+}
+
+// DeleteMetafield records the call and returns the results of DeleteMetafieldFunc
+func (m *PageService) DeleteMetafield(arg0 context.Context, arg1 int64, arg2 int64) error {
+	m.record("DeleteMetafield", arg0, arg1, arg2)
+	if m.DeleteMetafieldFunc != nil {
+		return m.DeleteMetafieldFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("PageService", "DeleteMetafield")
+}
+
+// PolicyService is a mock of goshopify.PolicyService
+type PolicyService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context) ([]goshopify.Policy, error)
+}
+
+var _ goshopify.PolicyService = (*PolicyService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *PolicyService) List(arg0 context.Context) ([]goshopify.Policy, error) {
+	m.record("List", arg0)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0)
+	}
+	var r0 []goshopify.Policy
+	return r0, m.unexpected("PolicyService", "List")
+}
+
+// PriceRuleService is a mock of goshopify.PriceRuleService
+type PriceRuleService struct {
+	Mock
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64) (*goshopify.PriceRule, error)
+
+	// GetBySinceIdFunc is called by GetBySinceId if set
+	GetBySinceIdFunc func(context.Context, int64, int, interface{}) ([]goshopify.PriceRule, error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.PriceRule) (*goshopify.PriceRule, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, goshopify.PriceRule) (*goshopify.PriceRule, error)
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context) ([]goshopify.PriceRule, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64) error
+}
+
+var _ goshopify.PriceRuleService = (*PriceRuleService)(nil)
+
+// Get records the call and returns the results of GetFunc
+func (m *PriceRuleService) Get(arg0 context.Context, arg1 int64) (*goshopify.PriceRule, error) {
+	m.record("Get", arg0, arg1)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1)
+	}
+	var r0 *goshopify.PriceRule
+	return r0, m.unexpected("PriceRuleService", "Get")
+}
+
+// GetBySinceId records the call and returns the results of GetBySinceIdFunc
+func (m *PriceRuleService) GetBySinceId(arg0 context.Context, arg1 int64, arg2 int, arg3 interface{}) ([]goshopify.PriceRule, error) {
+	m.record("GetBySinceId", arg0, arg1, arg2, arg3)
+	if m.GetBySinceIdFunc != nil {
+		return m.GetBySinceIdFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 []goshopify.PriceRule
+	return r0, m.unexpected("PriceRuleService", "GetBySinceId")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *PriceRuleService) Create(arg0 context.Context, arg1 goshopify.PriceRule) (*goshopify.PriceRule, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.PriceRule
+	return r0, m.unexpected("PriceRuleService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *PriceRuleService) Update(arg0 context.Context, arg1 goshopify.PriceRule) (*goshopify.PriceRule, error) {
+	m.record("Update", arg0, arg1)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.PriceRule
+	return r0, m.unexpected("PriceRuleService", "Update")
+}
+
+// List records the call and returns the results of ListFunc
+func (m *PriceRuleService) List(arg0 context.Context) ([]goshopify.PriceRule, error) {
+	m.record("List", arg0)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0)
+	}
+	var r0 []goshopify.PriceRule
+	return r0, m.unexpected("PriceRuleService", "List")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *PriceRuleService) Delete(arg0 context.Context, arg1 int64) error {
+	m.record("Delete", arg0, arg1)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1)
+	}
+	return m.unexpected("PriceRuleService", "Delete")
+}
+
+// ProductListingService is a mock of goshopify.ProductListingService
+type ProductListingService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.ProductListing, error)
+
+	// ListWithPaginationFunc is called by ListWithPagination if set
+	ListWithPaginationFunc func(context.Context, interface{}) ([]goshopify.ProductListing, *goshopify.Pagination, error)
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, interface{}) (int, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.ProductListing, error)
+
+	// GetProductIDsFunc is called by GetProductIDs if set
+	GetProductIDsFunc func(context.Context, interface{}) ([]int64, error)
+
+	// PublishFunc is called by Publish if set
+	PublishFunc func(context.Context, int64) (*goshopify.ProductListing, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64) error
+}
+
+var _ goshopify.ProductListingService = (*ProductListingService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *ProductListingService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.ProductListing, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.ProductListing
+	return r0, m.unexpected("ProductListingService", "List")
+}
+
+// ListWithPagination records the call and returns the results of ListWithPaginationFunc
+func (m *ProductListingService) ListWithPagination(arg0 context.Context, arg1 interface{}) ([]goshopify.ProductListing, *goshopify.Pagination, error) {
+	m.record("ListWithPagination", arg0, arg1)
+	if m.ListWithPaginationFunc != nil {
+		return m.ListWithPaginationFunc(arg0, arg1)
+	}
+	var r0 []goshopify.ProductListing
+	var r1 *goshopify.Pagination
+	return r0, r1, m.unexpected("ProductListingService", "ListWithPagination")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *ProductListingService) Count(arg0 context.Context, arg1 interface{}) (int, error) {
+	m.record("Count", arg0, arg1)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1)
+	}
+	var r0 int
+	return r0, m.unexpected("ProductListingService", "Count")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *ProductListingService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.ProductListing, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.ProductListing
+	return r0, m.unexpected("ProductListingService", "Get")
+}
+
+// GetProductIDs records the call and returns the results of GetProductIDsFunc
+func (m *ProductListingService) GetProductIDs(arg0 context.Context, arg1 interface{}) ([]int64, error) {
+	m.record("GetProductIDs", arg0, arg1)
+	if m.GetProductIDsFunc != nil {
+		return m.GetProductIDsFunc(arg0, arg1)
+	}
+	var r0 []int64
+	return r0, m.unexpected("ProductListingService", "GetProductIDs")
+}
+
+// Publish records the call and returns the results of PublishFunc
+func (m *ProductListingService) Publish(arg0 context.Context, arg1 int64) (*goshopify.ProductListing, error) {
+	m.record("Publish", arg0, arg1)
+	if m.PublishFunc != nil {
+		return m.PublishFunc(arg0, arg1)
+	}
+	var r0 *goshopify.ProductListing
+	return r0, m.unexpected("ProductListingService", "Publish")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *ProductListingService) Delete(arg0 context.Context, arg1 int64) error {
+	m.record("Delete", arg0, arg1)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1)
+	}
+	return m.unexpected("ProductListingService", "Delete")
+}
+
+// ProductService is a mock of goshopify.ProductService
+type ProductService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.Product, error)
+
+	// ListWithPaginationFunc is called by ListWithPagination if set
+	ListWithPaginationFunc func(context.Context, interface{}) ([]goshopify.Product, *goshopify.Pagination, error)
+
+	// StreamProductsFunc is called by StreamProducts if set
+	StreamProductsFunc func(context.Context, interface{}, func(goshopify.Product) error) error
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, interface{}) (int, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.Product, error)
+
+	// GetManyFunc is called by GetMany if set
+	GetManyFunc func(context.Context, []int64) (*goshopify.GetManyResult[goshopify.Product], error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.Product) (*goshopify.Product, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, goshopify.Product) (*goshopify.Product, error)
+
+	// PatchFunc is called by Patch if set
+	PatchFunc func(context.Context, int64, *goshopify.Patch[goshopify.Product]) (*goshopify.Product, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64) error
+
+	// ListMetafieldsFunc is called by ListMetafields if set
+	ListMetafieldsFunc func(context.Context, int64, interface{}) ([]goshopify.Metafield, error)
+
+	// CountMetafieldsFunc is called by CountMetafields if set
+	CountMetafieldsFunc func(context.Context, int64, interface{}) (int, error)
+
+	// GetMetafieldFunc is called by GetMetafield if set
+	GetMetafieldFunc func(context.Context, int64, int64, interface{}) (*goshopify.Metafield, error)
+
+	// CreateMetafieldFunc is called by CreateMetafield if set
+	CreateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// UpdateMetafieldFunc is called by UpdateMetafield if set
+	UpdateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// DeleteMetafieldFunc is called by DeleteMetafield if set
+	DeleteMetafieldFunc func(context.Context, int64, int64) error
+}
+
+var _ goshopify.ProductService = (*ProductService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *ProductService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.Product, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.Product
+	return r0, m.unexpected("ProductService", "List")
+}
+
+// ListWithPagination records the call and returns the results of ListWithPaginationFunc
+func (m *ProductService) ListWithPagination(arg0 context.Context, arg1 interface{}) ([]goshopify.Product, *goshopify.Pagination, error) {
+	m.record("ListWithPagination", arg0, arg1)
+	if m.ListWithPaginationFunc != nil {
+		return m.ListWithPaginationFunc(arg0, arg1)
+	}
+	var r0 []goshopify.Product
+	var r1 *goshopify.Pagination
+	return r0, r1, m.unexpected("ProductService", "ListWithPagination")
+}
+
+// StreamProducts records the call and returns the results of StreamProductsFunc
+func (m *ProductService) StreamProducts(arg0 context.Context, arg1 interface{}, arg2 func(goshopify.Product) error) error {
+	m.record("StreamProducts", arg0, arg1, arg2)
+	if m.StreamProductsFunc != nil {
+		return m.StreamProductsFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("ProductService", "StreamProducts")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *ProductService) Count(arg0 context.Context, arg1 interface{}) (int, error) {
+	m.record("Count", arg0, arg1)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1)
+	}
+	var r0 int
+	return r0, m.unexpected("ProductService", "Count")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *ProductService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.Product, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Product
+	return r0, m.unexpected("ProductService", "Get")
+}
+
+// GetMany records the call and returns the results of GetManyFunc
+func (m *ProductService) GetMany(arg0 context.Context, arg1 []int64) (*goshopify.GetManyResult[goshopify.Product], error) {
+	m.record("GetMany", arg0, arg1)
+	if m.GetManyFunc != nil {
+		return m.GetManyFunc(arg0, arg1)
+	}
+	var r0 *goshopify.GetManyResult[goshopify.Product]
+	return r0, m.unexpected("ProductService", "GetMany")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *ProductService) Create(arg0 context.Context, arg1 goshopify.Product) (*goshopify.Product, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Product
+	return r0, m.unexpected("ProductService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *ProductService) Update(arg0 context.Context, arg1 goshopify.Product) (*goshopify.Product, error) {
+	m.record("Update", arg0, arg1)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Product
+	return r0, m.unexpected("ProductService", "Update")
+}
+
+// Patch records the call and returns the results of PatchFunc
+func (m *ProductService) Patch(arg0 context.Context, arg1 int64, arg2 *goshopify.Patch[goshopify.Product]) (*goshopify.Product, error) {
+	m.record("Patch", arg0, arg1, arg2)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Product
+	return r0, m.unexpected("ProductService", "Patch")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *ProductService) Delete(arg0 context.Context, arg1 int64) error {
+	m.record("Delete", arg0, arg1)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1)
+	}
+	return m.unexpected("ProductService", "Delete")
+}
+
+// ListMetafields records the call and returns the results of ListMetafieldsFunc
+func (m *ProductService) ListMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Metafield, error) {
+	m.record("ListMetafields", arg0, arg1, arg2)
+	if m.ListMetafieldsFunc != nil {
+		return m.ListMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Metafield
+	return r0, m.unexpected("ProductService", "ListMetafields")
+}
+
+// CountMetafields records the call and returns the results of CountMetafieldsFunc
+func (m *ProductService) CountMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) (int, error) {
+	m.record("CountMetafields", arg0, arg1, arg2)
+	if m.CountMetafieldsFunc != nil {
+		return m.CountMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 int
+	return r0, m.unexpected("ProductService", "CountMetafields")
+}
+
+// GetMetafield records the call and returns the results of GetMetafieldFunc
+func (m *ProductService) GetMetafield(arg0 context.Context, arg1 int64, arg2 int64, arg3 interface{}) (*goshopify.Metafield, error) {
+	m.record("GetMetafield", arg0, arg1, arg2, arg3)
+	if m.GetMetafieldFunc != nil {
+		return m.GetMetafieldFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("ProductService", "GetMetafield")
+}
+
+// CreateMetafield records the call and returns the results of CreateMetafieldFunc
+func (m *ProductService) CreateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("CreateMetafield", arg0, arg1, arg2)
+	if m.CreateMetafieldFunc != nil {
+		return m.CreateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("ProductService", "CreateMetafield")
+}
+
+// UpdateMetafield records the call and returns the results of UpdateMetafieldFunc
+func (m *ProductService) UpdateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("UpdateMetafield", arg0, arg1, arg2)
+	if m.UpdateMetafieldFunc != nil {
+		return m.UpdateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("ProductService", "UpdateMetafield")
+}
+
+// DeleteMetafield records the call and returns the results of DeleteMetafieldFunc
+func (m *ProductService) DeleteMetafield(arg0 context.Context, arg1 int64, arg2 int64) error {
+	m.record("DeleteMetafield", arg0, arg1, arg2)
+	if m.DeleteMetafieldFunc != nil {
+		return m.DeleteMetafieldFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("ProductService", "DeleteMetafield")
+}
+
+// RecurringApplicationChargeService is a mock of goshopify.RecurringApplicationChargeService
+type RecurringApplicationChargeService struct {
+	Mock
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.RecurringApplicationCharge) (*goshopify.RecurringApplicationCharge, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.RecurringApplicationCharge, error)
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.RecurringApplicationCharge, error)
+
+	// ActivateFunc is called by Activate if set
+	ActivateFunc func(context.Context, goshopify.RecurringApplicationCharge) (*goshopify.RecurringApplicationCharge, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64) error
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, int64, int64) (*goshopify.RecurringApplicationCharge, error)
+}
+
+var _ goshopify.RecurringApplicationChargeService = (*RecurringApplicationChargeService)(nil)
+
+// Create records the call and returns the results of CreateFunc
+func (m *RecurringApplicationChargeService) Create(arg0 context.Context, arg1 goshopify.RecurringApplicationCharge) (*goshopify.RecurringApplicationCharge, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.RecurringApplicationCharge
+	return r0, m.unexpected("RecurringApplicationChargeService", "Create")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *RecurringApplicationChargeService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.RecurringApplicationCharge, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.RecurringApplicationCharge
+	return r0, m.unexpected("RecurringApplicationChargeService", "Get")
+}
+
+// List records the call and returns the results of ListFunc
+func (m *RecurringApplicationChargeService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.RecurringApplicationCharge, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.RecurringApplicationCharge
+	return r0, m.unexpected("RecurringApplicationChargeService", "List")
+}
+
+// Activate records the call and returns the results of ActivateFunc
+func (m *RecurringApplicationChargeService) Activate(arg0 context.Context, arg1 goshopify.RecurringApplicationCharge) (*goshopify.RecurringApplicationCharge, error) {
+	m.record("Activate", arg0, arg1)
+	if m.ActivateFunc != nil {
+		return m.ActivateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.RecurringApplicationCharge
+	return r0, m.unexpected("RecurringApplicationChargeService", "Activate")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *RecurringApplicationChargeService) Delete(arg0 context.Context, arg1 int64) error {
+	m.record("Delete", arg0, arg1)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1)
+	}
+	return m.unexpected("RecurringApplicationChargeService", "Delete")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *RecurringApplicationChargeService) Update(arg0 context.Context, arg1 int64, arg2 int64) (*goshopify.RecurringApplicationCharge, error) {
+	m.record("Update", arg0, arg1, arg2)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.RecurringApplicationCharge
+	return r0, m.unexpected("RecurringApplicationChargeService", "Update")
+}
+
+// RedirectService is a mock of goshopify.RedirectService
+type RedirectService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.Redirect, error)
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, interface{}) (int, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.Redirect, error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.Redirect) (*goshopify.Redirect, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, goshopify.Redirect) (*goshopify.Redirect, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64) error
+}
+
+var _ goshopify.RedirectService = (*RedirectService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *RedirectService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.Redirect, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.Redirect
+	return r0, m.unexpected("RedirectService", "List")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *RedirectService) Count(arg0 context.Context, arg1 interface{}) (int, error) {
+	m.record("Count", arg0, arg1)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1)
+	}
+	var r0 int
+	return r0, m.unexpected("RedirectService", "Count")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *RedirectService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.Redirect, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Redirect
+	return r0, m.unexpected("RedirectService", "Get")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *RedirectService) Create(arg0 context.Context, arg1 goshopify.Redirect) (*goshopify.Redirect, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Redirect
+	return r0, m.unexpected("RedirectService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *RedirectService) Update(arg0 context.Context, arg1 goshopify.Redirect) (*goshopify.Redirect, error) {
+	m.record("Update", arg0, arg1)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Redirect
+	return r0, m.unexpected("RedirectService", "Update")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *RedirectService) Delete(arg0 context.Context, arg1 int64) error {
+	m.record("Delete", arg0, arg1)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1)
+	}
+	return m.unexpected("RedirectService", "Delete")
+}
+
+// ScriptTagService is a mock of goshopify.ScriptTagService
+type ScriptTagService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.ScriptTag, error)
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, interface{}) (int, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.ScriptTag, error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.ScriptTag) (*goshopify.ScriptTag, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, goshopify.ScriptTag) (*goshopify.ScriptTag, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64) error
+}
+
+var _ goshopify.ScriptTagService = (*ScriptTagService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *ScriptTagService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.ScriptTag, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.ScriptTag
+	return r0, m.unexpected("ScriptTagService", "List")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *ScriptTagService) Count(arg0 context.Context, arg1 interface{}) (int, error) {
+	m.record("Count", arg0, arg1)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1)
+	}
+	var r0 int
+	return r0, m.unexpected("ScriptTagService", "Count")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *ScriptTagService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.ScriptTag, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.ScriptTag
+	return r0, m.unexpected("ScriptTagService", "Get")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *ScriptTagService) Create(arg0 context.Context, arg1 goshopify.ScriptTag) (*goshopify.ScriptTag, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.ScriptTag
+	return r0, m.unexpected("ScriptTagService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *ScriptTagService) Update(arg0 context.Context, arg1 goshopify.ScriptTag) (*goshopify.ScriptTag, error) {
+	m.record("Update", arg0, arg1)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.ScriptTag
+	return r0, m.unexpected("ScriptTagService", "Update")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *ScriptTagService) Delete(arg0 context.Context, arg1 int64) error {
+	m.record("Delete", arg0, arg1)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1)
+	}
+	return m.unexpected("ScriptTagService", "Delete")
+}
+
+// ShippingZoneService is a mock of goshopify.ShippingZoneService
+type ShippingZoneService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context) ([]goshopify.ShippingZone, error)
+}
+
+var _ goshopify.ShippingZoneService = (*ShippingZoneService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *ShippingZoneService) List(arg0 context.Context) ([]goshopify.ShippingZone, error) {
+	m.record("List", arg0)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0)
+	}
+	var r0 []goshopify.ShippingZone
+	return r0, m.unexpected("ShippingZoneService", "List")
+}
+
+// ShopService is a mock of goshopify.ShopService
+type ShopService struct {
+	Mock
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, interface{}) (*goshopify.Shop, error)
+}
+
+var _ goshopify.ShopService = (*ShopService)(nil)
+
+// Get records the call and returns the results of GetFunc
+func (m *ShopService) Get(arg0 context.Context, arg1 interface{}) (*goshopify.Shop, error) {
+	m.record("Get", arg0, arg1)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Shop
+	return r0, m.unexpected("ShopService", "Get")
+}
+
+// SmartCollectionService is a mock of goshopify.SmartCollectionService
+type SmartCollectionService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.SmartCollection, error)
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, interface{}) (int, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.SmartCollection, error)
+
+	// GetManyFunc is called by GetMany if set
+	GetManyFunc func(context.Context, []int64) (*goshopify.GetManyResult[goshopify.SmartCollection], error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.SmartCollection) (*goshopify.SmartCollection, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, goshopify.SmartCollection) (*goshopify.SmartCollection, error)
+
+	// PatchFunc is called by Patch if set
+	PatchFunc func(context.Context, int64, *goshopify.Patch[goshopify.SmartCollection]) (*goshopify.SmartCollection, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64) error
+
+	// ListMetafieldsFunc is called by ListMetafields if set
+	ListMetafieldsFunc func(context.Context, int64, interface{}) ([]goshopify.Metafield, error)
+
+	// CountMetafieldsFunc is called by CountMetafields if set
+	CountMetafieldsFunc func(context.Context, int64, interface{}) (int, error)
+
+	// GetMetafieldFunc is called by GetMetafield if set
+	GetMetafieldFunc func(context.Context, int64, int64, interface{}) (*goshopify.Metafield, error)
+
+	// CreateMetafieldFunc is called by CreateMetafield if set
+	CreateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// UpdateMetafieldFunc is called by UpdateMetafield if set
+	UpdateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// DeleteMetafieldFunc is called by DeleteMetafield if set
+	DeleteMetafieldFunc func(context.Context, int64, int64) error
+}
+
+var _ goshopify.SmartCollectionService = (*SmartCollectionService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *SmartCollectionService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.SmartCollection, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.SmartCollection
+	return r0, m.unexpected("SmartCollectionService", "List")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *SmartCollectionService) Count(arg0 context.Context, arg1 interface{}) (int, error) {
+	m.record("Count", arg0, arg1)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1)
+	}
+	var r0 int
+	return r0, m.unexpected("SmartCollectionService", "Count")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *SmartCollectionService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.SmartCollection, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.SmartCollection
+	return r0, m.unexpected("SmartCollectionService", "Get")
+}
+
+// GetMany records the call and returns the results of GetManyFunc
+func (m *SmartCollectionService) GetMany(arg0 context.Context, arg1 []int64) (*goshopify.GetManyResult[goshopify.SmartCollection], error) {
+	m.record("GetMany", arg0, arg1)
+	if m.GetManyFunc != nil {
+		return m.GetManyFunc(arg0, arg1)
+	}
+	var r0 *goshopify.GetManyResult[goshopify.SmartCollection]
+	return r0, m.unexpected("SmartCollectionService", "GetMany")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *SmartCollectionService) Create(arg0 context.Context, arg1 goshopify.SmartCollection) (*goshopify.SmartCollection, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.SmartCollection
+	return r0, m.unexpected("SmartCollectionService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *SmartCollectionService) Update(arg0 context.Context, arg1 goshopify.SmartCollection) (*goshopify.SmartCollection, error) {
+	m.record("Update", arg0, arg1)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.SmartCollection
+	return r0, m.unexpected("SmartCollectionService", "Update")
+}
+
+// Patch records the call and returns the results of PatchFunc
+func (m *SmartCollectionService) Patch(arg0 context.Context, arg1 int64, arg2 *goshopify.Patch[goshopify.SmartCollection]) (*goshopify.SmartCollection, error) {
+	m.record("Patch", arg0, arg1, arg2)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.SmartCollection
+	return r0, m.unexpected("SmartCollectionService", "Patch")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *SmartCollectionService) Delete(arg0 context.Context, arg1 int64) error {
+	m.record("Delete", arg0, arg1)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1)
+	}
+	return m.unexpected("SmartCollectionService", "Delete")
+}
+
+// ListMetafields records the call and returns the results of ListMetafieldsFunc
+func (m *SmartCollectionService) ListMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Metafield, error) {
+	m.record("ListMetafields", arg0, arg1, arg2)
+	if m.ListMetafieldsFunc != nil {
+		return m.ListMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Metafield
+	return r0, m.unexpected("SmartCollectionService", "ListMetafields")
+}
+
+// CountMetafields records the call and returns the results of CountMetafieldsFunc
+func (m *SmartCollectionService) CountMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) (int, error) {
+	m.record("CountMetafields", arg0, arg1, arg2)
+	if m.CountMetafieldsFunc != nil {
+		return m.CountMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 int
+	return r0, m.unexpected("SmartCollectionService", "CountMetafields")
+}
+
+// GetMetafield records the call and returns the results of GetMetafieldFunc
+func (m *SmartCollectionService) GetMetafield(arg0 context.Context, arg1 int64, arg2 int64, arg3 interface{}) (*goshopify.Metafield, error) {
+	m.record("GetMetafield", arg0, arg1, arg2, arg3)
+	if m.GetMetafieldFunc != nil {
+		return m.GetMetafieldFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("SmartCollectionService", "GetMetafield")
+}
+
+// CreateMetafield records the call and returns the results of CreateMetafieldFunc
+func (m *SmartCollectionService) CreateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("CreateMetafield", arg0, arg1, arg2)
+	if m.CreateMetafieldFunc != nil {
+		return m.CreateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("SmartCollectionService", "CreateMetafield")
+}
+
+// UpdateMetafield records the call and returns the results of UpdateMetafieldFunc
+func (m *SmartCollectionService) UpdateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("UpdateMetafield", arg0, arg1, arg2)
+	if m.UpdateMetafieldFunc != nil {
+		return m.UpdateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("SmartCollectionService", "UpdateMetafield")
+}
+
+// DeleteMetafield records the call and returns the results of DeleteMetafieldFunc
+func (m *SmartCollectionService) DeleteMetafield(arg0 context.Context, arg1 int64, arg2 int64) error {
+	m.record("DeleteMetafield", arg0, arg1, arg2)
+	if m.DeleteMetafieldFunc != nil {
+		return m.DeleteMetafieldFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("SmartCollectionService", "DeleteMetafield")
+}
+
+// StorefrontAccessTokenService is a mock of goshopify.StorefrontAccessTokenService
+type StorefrontAccessTokenService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.StorefrontAccessToken, error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.StorefrontAccessToken) (*goshopify.StorefrontAccessToken, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64) error
+}
+
+var _ goshopify.StorefrontAccessTokenService = (*StorefrontAccessTokenService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *StorefrontAccessTokenService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.StorefrontAccessToken, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.StorefrontAccessToken
+	return r0, m.unexpected("StorefrontAccessTokenService", "List")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *StorefrontAccessTokenService) Create(arg0 context.Context, arg1 goshopify.StorefrontAccessToken) (*goshopify.StorefrontAccessToken, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.StorefrontAccessToken
+	return r0, m.unexpected("StorefrontAccessTokenService", "Create")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *StorefrontAccessTokenService) Delete(arg0 context.Context, arg1 int64) error {
+	m.record("Delete", arg0, arg1)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1)
+	}
+	return m.unexpected("StorefrontAccessTokenService", "Delete")
+}
+
+// ThemeService is a mock of goshopify.ThemeService
+type ThemeService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.Theme, error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.Theme) (*goshopify.Theme, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.Theme, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, goshopify.Theme) (*goshopify.Theme, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64) error
+}
+
+var _ goshopify.ThemeService = (*ThemeService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *ThemeService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.Theme, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.Theme
+	return r0, m.unexpected("ThemeService", "List")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *ThemeService) Create(arg0 context.Context, arg1 goshopify.Theme) (*goshopify.Theme, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Theme
+	return r0, m.unexpected("ThemeService", "Create")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *ThemeService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.Theme, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Theme
+	return r0, m.unexpected("ThemeService", "Get")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *ThemeService) Update(arg0 context.Context, arg1 goshopify.Theme) (*goshopify.Theme, error) {
+	m.record("Update", arg0, arg1)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Theme
+	return r0, m.unexpected("ThemeService", "Update")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *ThemeService) Delete(arg0 context.Context, arg1 int64) error {
+	m.record("Delete", arg0, arg1)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1)
+	}
+	return m.unexpected("ThemeService", "Delete")
+}
+
+// TransactionService is a mock of goshopify.TransactionService
+type TransactionService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, int64, interface{}) ([]goshopify.Transaction, error)
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, int64, interface{}) (int, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, int64, interface{}) (*goshopify.Transaction, error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, int64, goshopify.Transaction) (*goshopify.Transaction, error)
+}
+
+var _ goshopify.TransactionService = (*TransactionService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *TransactionService) List(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Transaction, error) {
+	m.record("List", arg0, arg1, arg2)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Transaction
+	return r0, m.unexpected("TransactionService", "List")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *TransactionService) Count(arg0 context.Context, arg1 int64, arg2 interface{}) (int, error) {
+	m.record("Count", arg0, arg1, arg2)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1, arg2)
+	}
+	var r0 int
+	return r0, m.unexpected("TransactionService", "Count")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *TransactionService) Get(arg0 context.Context, arg1 int64, arg2 int64, arg3 interface{}) (*goshopify.Transaction, error) {
+	m.record("Get", arg0, arg1, arg2, arg3)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.Transaction
+	return r0, m.unexpected("TransactionService", "Get")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *TransactionService) Create(arg0 context.Context, arg1 int64, arg2 goshopify.Transaction) (*goshopify.Transaction, error) {
+	m.record("Create", arg0, arg1, arg2)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Transaction
+	return r0, m.unexpected("TransactionService", "Create")
+}
+
+// UsageChargeService is a mock of goshopify.UsageChargeService
+type UsageChargeService struct {
+	Mock
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, int64, goshopify.UsageCharge) (*goshopify.UsageCharge, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, int64, interface{}) (*goshopify.UsageCharge, error)
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, int64, interface{}) ([]goshopify.UsageCharge, error)
+}
+
+var _ goshopify.UsageChargeService = (*UsageChargeService)(nil)
+
+// Create records the call and returns the results of CreateFunc
+func (m *UsageChargeService) Create(arg0 context.Context, arg1 int64, arg2 goshopify.UsageCharge) (*goshopify.UsageCharge, error) {
+	m.record("Create", arg0, arg1, arg2)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.UsageCharge
+	return r0, m.unexpected("UsageChargeService", "Create")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *UsageChargeService) Get(arg0 context.Context, arg1 int64, arg2 int64, arg3 interface{}) (*goshopify.UsageCharge, error) {
+	m.record("Get", arg0, arg1, arg2, arg3)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.UsageCharge
+	return r0, m.unexpected("UsageChargeService", "Get")
+}
+
+// List records the call and returns the results of ListFunc
+func (m *UsageChargeService) List(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.UsageCharge, error) {
+	m.record("List", arg0, arg1, arg2)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.UsageCharge
+	return r0, m.unexpected("UsageChargeService", "List")
+}
+
+// UserService is a mock of goshopify.UserService
+type UserService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.User, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.User, error)
+
+	// CurrentFunc is called by Current if set
+	CurrentFunc func(context.Context) (*goshopify.User, error)
+}
+
+var _ goshopify.UserService = (*UserService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *UserService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.User, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.User
+	return r0, m.unexpected("UserService", "List")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *UserService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.User, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.User
+	return r0, m.unexpected("UserService", "Get")
+}
+
+// Current records the call and returns the results of CurrentFunc
+func (m *UserService) Current(arg0 context.Context) (*goshopify.User, error) {
+	m.record("Current", arg0)
+	if m.CurrentFunc != nil {
+		return m.CurrentFunc(arg0)
+	}
+	var r0 *goshopify.User
+	return r0, m.unexpected("UserService", "Current")
+}
+
+// VariantService is a mock of goshopify.VariantService
+type VariantService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, int64, interface{}) ([]goshopify.Variant, error)
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, int64, interface{}) (int, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.Variant, error)
+
+	// GetManyFunc is called by GetMany if set
+	GetManyFunc func(context.Context, []int64) (*goshopify.GetManyResult[goshopify.Variant], error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, int64, goshopify.Variant) (*goshopify.Variant, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, goshopify.Variant) (*goshopify.Variant, error)
+
+	// PatchFunc is called by Patch if set
+	PatchFunc func(context.Context, int64, *goshopify.Patch[goshopify.Variant]) (*goshopify.Variant, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64, int64) error
+
+	// ListMetafieldsFunc is called by ListMetafields if set
+	ListMetafieldsFunc func(context.Context, int64, interface{}) ([]goshopify.Metafield, error)
+
+	// CountMetafieldsFunc is called by CountMetafields if set
+	CountMetafieldsFunc func(context.Context, int64, interface{}) (int, error)
+
+	// GetMetafieldFunc is called by GetMetafield if set
+	GetMetafieldFunc func(context.Context, int64, int64, interface{}) (*goshopify.Metafield, error)
+
+	// CreateMetafieldFunc is called by CreateMetafield if set
+	CreateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// UpdateMetafieldFunc is called by UpdateMetafield if set
+	UpdateMetafieldFunc func(context.Context, int64, goshopify.Metafield) (*goshopify.Metafield, error)
+
+	// DeleteMetafieldFunc is called by DeleteMetafield if set
+	DeleteMetafieldFunc func(context.Context, int64, int64) error
+}
+
+var _ goshopify.VariantService = (*VariantService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *VariantService) List(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Variant, error) {
+	m.record("List", arg0, arg1, arg2)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Variant
+	return r0, m.unexpected("VariantService", "List")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *VariantService) Count(arg0 context.Context, arg1 int64, arg2 interface{}) (int, error) {
+	m.record("Count", arg0, arg1, arg2)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1, arg2)
+	}
+	var r0 int
+	return r0, m.unexpected("VariantService", "Count")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *VariantService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.Variant, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Variant
+	return r0, m.unexpected("VariantService", "Get")
+}
+
+// GetMany records the call and returns the results of GetManyFunc
+func (m *VariantService) GetMany(arg0 context.Context, arg1 []int64) (*goshopify.GetManyResult[goshopify.Variant], error) {
+	m.record("GetMany", arg0, arg1)
+	if m.GetManyFunc != nil {
+		return m.GetManyFunc(arg0, arg1)
+	}
+	var r0 *goshopify.GetManyResult[goshopify.Variant]
+	return r0, m.unexpected("VariantService", "GetMany")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *VariantService) Create(arg0 context.Context, arg1 int64, arg2 goshopify.Variant) (*goshopify.Variant, error) {
+	m.record("Create", arg0, arg1, arg2)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Variant
+	return r0, m.unexpected("VariantService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *VariantService) Update(arg0 context.Context, arg1 goshopify.Variant) (*goshopify.Variant, error) {
+	m.record("Update", arg0, arg1)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Variant
+	return r0, m.unexpected("VariantService", "Update")
+}
+
+// Patch records the call and returns the results of PatchFunc
+func (m *VariantService) Patch(arg0 context.Context, arg1 int64, arg2 *goshopify.Patch[goshopify.Variant]) (*goshopify.Variant, error) {
+	m.record("Patch", arg0, arg1, arg2)
+	if m.PatchFunc != nil {
+		return m.PatchFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Variant
+	return r0, m.unexpected("VariantService", "Patch")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *VariantService) Delete(arg0 context.Context, arg1 int64, arg2 int64) error {
+	m.record("Delete", arg0, arg1, arg2)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("VariantService", "Delete")
+}
+
+// ListMetafields records the call and returns the results of ListMetafieldsFunc
+func (m *VariantService) ListMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) ([]goshopify.Metafield, error) {
+	m.record("ListMetafields", arg0, arg1, arg2)
+	if m.ListMetafieldsFunc != nil {
+		return m.ListMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 []goshopify.Metafield
+	return r0, m.unexpected("VariantService", "ListMetafields")
+}
+
+// CountMetafields records the call and returns the results of CountMetafieldsFunc
+func (m *VariantService) CountMetafields(arg0 context.Context, arg1 int64, arg2 interface{}) (int, error) {
+	m.record("CountMetafields", arg0, arg1, arg2)
+	if m.CountMetafieldsFunc != nil {
+		return m.CountMetafieldsFunc(arg0, arg1, arg2)
+	}
+	var r0 int
+	return r0, m.unexpected("VariantService", "CountMetafields")
+}
+
+// GetMetafield records the call and returns the results of GetMetafieldFunc
+func (m *VariantService) GetMetafield(arg0 context.Context, arg1 int64, arg2 int64, arg3 interface{}) (*goshopify.Metafield, error) {
+	m.record("GetMetafield", arg0, arg1, arg2, arg3)
+	if m.GetMetafieldFunc != nil {
+		return m.GetMetafieldFunc(arg0, arg1, arg2, arg3)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("VariantService", "GetMetafield")
+}
+
+// CreateMetafield records the call and returns the results of CreateMetafieldFunc
+func (m *VariantService) CreateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("CreateMetafield", arg0, arg1, arg2)
+	if m.CreateMetafieldFunc != nil {
+		return m.CreateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("VariantService", "CreateMetafield")
+}
+
+// UpdateMetafield records the call and returns the results of UpdateMetafieldFunc
+func (m *VariantService) UpdateMetafield(arg0 context.Context, arg1 int64, arg2 goshopify.Metafield) (*goshopify.Metafield, error) {
+	m.record("UpdateMetafield", arg0, arg1, arg2)
+	if m.UpdateMetafieldFunc != nil {
+		return m.UpdateMetafieldFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Metafield
+	return r0, m.unexpected("VariantService", "UpdateMetafield")
+}
+
+// DeleteMetafield records the call and returns the results of DeleteMetafieldFunc
+func (m *VariantService) DeleteMetafield(arg0 context.Context, arg1 int64, arg2 int64) error {
+	m.record("DeleteMetafield", arg0, arg1, arg2)
+	if m.DeleteMetafieldFunc != nil {
+		return m.DeleteMetafieldFunc(arg0, arg1, arg2)
+	}
+	return m.unexpected("VariantService", "DeleteMetafield")
+}
+
+// WebhookService is a mock of goshopify.WebhookService
+type WebhookService struct {
+	Mock
+
+	// ListFunc is called by List if set
+	ListFunc func(context.Context, interface{}) ([]goshopify.Webhook, error)
+
+	// CountFunc is called by Count if set
+	CountFunc func(context.Context, interface{}) (int, error)
+
+	// GetFunc is called by Get if set
+	GetFunc func(context.Context, int64, interface{}) (*goshopify.Webhook, error)
+
+	// CreateFunc is called by Create if set
+	CreateFunc func(context.Context, goshopify.Webhook) (*goshopify.Webhook, error)
+
+	// UpdateFunc is called by Update if set
+	UpdateFunc func(context.Context, goshopify.Webhook) (*goshopify.Webhook, error)
+
+	// DeleteFunc is called by Delete if set
+	DeleteFunc func(context.Context, int64) error
+}
+
+var _ goshopify.WebhookService = (*WebhookService)(nil)
+
+// List records the call and returns the results of ListFunc
+func (m *WebhookService) List(arg0 context.Context, arg1 interface{}) ([]goshopify.Webhook, error) {
+	m.record("List", arg0, arg1)
+	if m.ListFunc != nil {
+		return m.ListFunc(arg0, arg1)
+	}
+	var r0 []goshopify.Webhook
+	return r0, m.unexpected("WebhookService", "List")
+}
+
+// Count records the call and returns the results of CountFunc
+func (m *WebhookService) Count(arg0 context.Context, arg1 interface{}) (int, error) {
+	m.record("Count", arg0, arg1)
+	if m.CountFunc != nil {
+		return m.CountFunc(arg0, arg1)
+	}
+	var r0 int
+	return r0, m.unexpected("WebhookService", "Count")
+}
+
+// Get records the call and returns the results of GetFunc
+func (m *WebhookService) Get(arg0 context.Context, arg1 int64, arg2 interface{}) (*goshopify.Webhook, error) {
+	m.record("Get", arg0, arg1, arg2)
+	if m.GetFunc != nil {
+		return m.GetFunc(arg0, arg1, arg2)
+	}
+	var r0 *goshopify.Webhook
+	return r0, m.unexpected("WebhookService", "Get")
+}
+
+// Create records the call and returns the results of CreateFunc
+func (m *WebhookService) Create(arg0 context.Context, arg1 goshopify.Webhook) (*goshopify.Webhook, error) {
+	m.record("Create", arg0, arg1)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Webhook
+	return r0, m.unexpected("WebhookService", "Create")
+}
+
+// Update records the call and returns the results of UpdateFunc
+func (m *WebhookService) Update(arg0 context.Context, arg1 goshopify.Webhook) (*goshopify.Webhook, error) {
+	m.record("Update", arg0, arg1)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(arg0, arg1)
+	}
+	var r0 *goshopify.Webhook
+	return r0, m.unexpected("WebhookService", "Update")
+}
+
+// Delete records the call and returns the results of DeleteFunc
+func (m *WebhookService) Delete(arg0 context.Context, arg1 int64) error {
+	m.record("Delete", arg0, arg1)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(arg0, arg1)
+	}
+	return m.unexpected("WebhookService", "Delete")
+}
+
+// Services holds the mocks of the services of a client built by NewClient
+type Services struct {
+	Product                    *ProductService
+	CustomCollection           *CustomCollectionService
+	SmartCollection            *SmartCollectionService
+	Customer                   *CustomerService
+	CustomerAddress            *CustomerAddressService
+	CustomerSavedSearch        *CustomerSavedSearchService
+	Order                      *OrderService
+	Fulfillment                *FulfillmentService
+	DraftOrder                 *DraftOrderService
+	Shop                       *ShopService
+	Webhook                    *WebhookService
+	Variant                    *VariantService
+	Image                      *ImageService
+	Transaction                *TransactionService
+	Theme                      *ThemeService
+	Asset                      *AssetService
+	ScriptTag                  *ScriptTagService
+	RecurringApplicationCharge *RecurringApplicationChargeService
+	UsageCharge                *UsageChargeService
+	Metafield                  *MetafieldService
+	Blog                       *BlogService
+	Article                    *ArticleService
+	ApplicationCharge          *ApplicationChargeService
+	Redirect                   *RedirectService
+	Page                       *PageService
+	StorefrontAccessToken      *StorefrontAccessTokenService
+	AccessScope                *AccessScopeService
+	Collect                    *CollectService
+	Collection                 *CollectionService
+	Location                   *LocationService
+	DiscountCode               *DiscountCodeService
+	PriceRule                  *PriceRuleService
+	InventoryItem              *InventoryItemService
+	ShippingZone               *ShippingZoneService
+	ProductListing             *ProductListingService
+	Policy                     *PolicyService
+	Currency                   *CurrencyService
+	User                       *UserService
+}
+
+// NewClient returns a client with all its services replaced by new mocks,
+// and the mocks to configure
+func NewClient(opts ...goshopify.Option) (*goshopify.Client, *Services) {
+	mocks := &Services{
+		Product:                    &ProductService{},
+		CustomCollection:           &CustomCollectionService{},
+		SmartCollection:            &SmartCollectionService{},
+		Customer:                   &CustomerService{},
+		CustomerAddress:            &CustomerAddressService{},
+		CustomerSavedSearch:        &CustomerSavedSearchService{},
+		Order:                      &OrderService{},
+		Fulfillment:                &FulfillmentService{},
+		DraftOrder:                 &DraftOrderService{},
+		Shop:                       &ShopService{},
+		Webhook:                    &WebhookService{},
+		Variant:                    &VariantService{},
+		Image:                      &ImageService{},
+		Transaction:                &TransactionService{},
+		Theme:                      &ThemeService{},
+		Asset:                      &AssetService{},
+		ScriptTag:                  &ScriptTagService{},
+		RecurringApplicationCharge: &RecurringApplicationChargeService{},
+		UsageCharge:                &UsageChargeService{},
+		Metafield:                  &MetafieldService{},
+		Blog:                       &BlogService{},
+		Article:                    &ArticleService{},
+		ApplicationCharge:          &ApplicationChargeService{},
+		Redirect:                   &RedirectService{},
+		Page:                       &PageService{},
+		StorefrontAccessToken:      &StorefrontAccessTokenService{},
+		AccessScope:                &AccessScopeService{},
+		Collect:                    &CollectService{},
+		Collection:                 &CollectionService{},
+		Location:                   &LocationService{},
+		DiscountCode:               &DiscountCodeService{},
+		PriceRule:                  &PriceRuleService{},
+		InventoryItem:              &InventoryItemService{},
+		ShippingZone:               &ShippingZoneService{},
+		ProductListing:             &ProductListingService{},
+		Policy:                     &PolicyService{},
+		Currency:                   &CurrencyService{},
+		User:                       &UserService{},
+	}
+
+	client := goshopify.NewClient(goshopify.App{}, "fooshop", "", opts...)
+	client.Product = mocks.Product
+	client.CustomCollection = mocks.CustomCollection
+	client.SmartCollection = mocks.SmartCollection
+	client.Customer = mocks.Customer
+	client.CustomerAddress = mocks.CustomerAddress
+	client.CustomerSavedSearch = mocks.CustomerSavedSearch
+	client.Order = mocks.Order
+	client.Fulfillment = mocks.Fulfillment
+	client.DraftOrder = mocks.DraftOrder
+	client.Shop = mocks.Shop
+	client.Webhook = mocks.Webhook
+	client.Variant = mocks.Variant
+	client.Image = mocks.Image
+	client.Transaction = mocks.Transaction
+	client.Theme = mocks.Theme
+	client.Asset = mocks.Asset
+	client.ScriptTag = mocks.ScriptTag
+	client.RecurringApplicationCharge = mocks.RecurringApplicationCharge
+	client.UsageCharge = mocks.UsageCharge
+	client.Metafield = mocks.Metafield
+	client.Blog = mocks.Blog
+	client.Article = mocks.Article
+	client.ApplicationCharge = mocks.ApplicationCharge
+	client.Redirect = mocks.Redirect
+	client.Page = mocks.Page
+	client.StorefrontAccessToken = mocks.StorefrontAccessToken
+	client.AccessScope = mocks.AccessScope
+	client.Collect = mocks.Collect
+	client.Collection = mocks.Collection
+	client.Location = mocks.Location
+	client.DiscountCode = mocks.DiscountCode
+	client.PriceRule = mocks.PriceRule
+	client.InventoryItem = mocks.InventoryItem
+	client.ShippingZone = mocks.ShippingZone
+	client.ProductListing = mocks.ProductListing
+	client.Policy = mocks.Policy
+	client.Currency = mocks.Currency
+	client.User = mocks.User
+	return client, mocks
+}
@@ -0,0 +1,368 @@
+package goshopifytest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	goshopify "github.com/gempages/go-shopify"
+)
+
+// DefaultCassetteDir is the directory cassettes are stored in, relative to
+// the package under test like the other fixtures
+const DefaultCassetteDir = "fixtures/cassettes"
+
+// cassetteScrubHeaders are the headers masked in cassettes
+var cassetteScrubHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Shopify-Access-Token",
+	"X-Shopify-Storefront-Access-Token",
+	"X-Shopify-Hmac-Sha256",
+}
+
+// CassetteMode tells a Recorder whether to record or replay interactions
+type CassetteMode int
+
+const (
+	// ModeReplay answers requests with the interactions of the cassette
+	ModeReplay CassetteMode = iota
+
+	// ModeRecord sends requests to Shopify and writes the interactions to
+	// the cassette on Stop
+	ModeRecord
+)
+
+// Cassette is the content of a cassette file
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest is a recorded request
+type CassetteRequest struct {
+	Method string       `json:"method"`
+	URL    string       `json:"url"`
+	Header http.Header  `json:"header,omitempty"`
+	Body   CassetteBody `json:"body,omitempty"`
+}
+
+// CassetteResponse is a recorded response
+type CassetteResponse struct {
+	Status int          `json:"status"`
+	Header http.Header  `json:"header,omitempty"`
+	Body   CassetteBody `json:"body,omitempty"`
+}
+
+// CassetteBody is a recorded body, it is written as JSON to the cassette if
+// it is JSON and as a string otherwise to keep cassettes readable
+type CassetteBody []byte
+
+// MarshalJSON implements json.Marshaler
+func (b CassetteBody) MarshalJSON() ([]byte, error) {
+	trimmed := bytes.TrimSpace(b)
+	if len(trimmed) > 0 && trimmed[0] != '"' && json.Valid(trimmed) {
+		return trimmed, nil
+	}
+	return json.Marshal(string(b))
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (b *CassetteBody) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*b = CassetteBody(s)
+		return nil
+	}
+	*b = append((*b)[:0], data...)
+	return nil
+}
+
+// RecorderOption is used to configure a Recorder
+type RecorderOption func(r *Recorder)
+
+// WithCassetteDir sets the directory of the cassette, defaults to
+// DefaultCassetteDir
+func WithCassetteDir(dir string) RecorderOption {
+	return func(r *Recorder) {
+		r.dir = dir
+	}
+}
+
+// WithStrict makes a replaying Recorder fail requests matching no
+// interaction instead of sending them, and makes Stop report interactions
+// which were never replayed
+func WithStrict() RecorderOption {
+	return func(r *Recorder) {
+		r.strict = true
+	}
+}
+
+// WithScrubKeys masks the values of the given JSON keys and query params in
+// cassettes in addition to goshopify.DefaultRedactKeys
+func WithScrubKeys(keys ...string) RecorderOption {
+	return func(r *Recorder) {
+		r.redactor = goshopify.NewRedactor(keys...)
+	}
+}
+
+// WithTransport sets the transport sending the requests to Shopify when
+// recording, or when replaying requests matching no interaction without
+// WithStrict. Defaults to http.DefaultTransport.
+func WithTransport(transport http.RoundTripper) RecorderOption {
+	return func(r *Recorder) {
+		r.transport = transport
+	}
+}
+
+// Recorder is an http.RoundTripper recording interactions with Shopify to a
+// cassette file and replaying them. Access tokens, HMACs and the values of
+// goshopify.DefaultRedactKeys are masked in the cassette. Requests are
+// matched on method, path, query and body, the first interaction not
+// replayed yet is used when several match.
+//
+//	rec, err := goshopifytest.NewRecorder("products", goshopifytest.ModeReplay)
+//	defer rec.Stop()
+//	client := goshopify.NewClient(app, "fooshop", token, goshopify.WithHTTPClient(rec.HTTPClient()))
+type Recorder struct {
+	name      string
+	mode      CassetteMode
+	dir       string
+	strict    bool
+	redactor  *goshopify.Redactor
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	replayed []bool
+}
+
+// NewRecorder returns a Recorder for the cassette file <name>.json. When
+// replaying, the cassette must exist.
+func NewRecorder(name string, mode CassetteMode, opts ...RecorderOption) (*Recorder, error) {
+	r := &Recorder{
+		name:      name,
+		mode:      mode,
+		dir:       DefaultCassetteDir,
+		redactor:  goshopify.NewRedactor(),
+		transport: http.DefaultTransport,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if mode == ModeReplay {
+		data, err := os.ReadFile(r.Path())
+		if err != nil {
+			return nil, fmt.Errorf("goshopifytest: load cassette: %w", err)
+		}
+		if err := json.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("goshopifytest: decode cassette %s: %w", r.Path(), err)
+		}
+		r.replayed = make([]bool, len(r.cassette.Interactions))
+	}
+	return r, nil
+}
+
+// Path returns the path of the cassette file
+func (r *Recorder) Path() string {
+	return filepath.Join(r.dir, r.name+".json")
+}
+
+// HTTPClient returns an http.Client using the Recorder, to be passed to
+// goshopify.WithHTTPClient
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if r.mode == ModeRecord {
+		return r.record(req, body)
+	}
+
+	if interaction := r.match(req, body); interaction != nil {
+		return interaction.Response.toHTTP(req), nil
+	}
+	if r.strict {
+		return nil, fmt.Errorf("goshopifytest: no interaction of cassette %s matches %s %s", r.name, req.Method, r.redactor.RedactURL(req.URL))
+	}
+	return r.transport.RoundTrip(req)
+}
+
+// Stop ends the recording by writing the cassette file. In strict replay
+// mode it returns an error if some interactions were never replayed.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.mode == ModeReplay {
+		if !r.strict {
+			return nil
+		}
+		var unused []string
+		for i, replayed := range r.replayed {
+			if !replayed {
+				req := r.cassette.Interactions[i].Request
+				unused = append(unused, req.Method+" "+req.URL)
+			}
+		}
+		if len(unused) > 0 {
+			return fmt.Errorf("goshopifytest: interactions of cassette %s never replayed: %s", r.name, strings.Join(unused, ", "))
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("goshopifytest: encode cassette: %w", err)
+	}
+	if err := os.MkdirAll(r.dir, 0o755); err != nil {
+		return fmt.Errorf("goshopifytest: write cassette: %w", err)
+	}
+	if err := os.WriteFile(r.Path(), append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("goshopifytest: write cassette: %w", err)
+	}
+	return nil
+}
+
+// record sends a request and adds the scrubbed interaction to the cassette
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &Interaction{
+		Request: CassetteRequest{
+			Method: req.Method,
+			URL:    r.redactor.RedactURL(req.URL),
+			Header: r.scrubHeader(req.Header),
+			Body:   r.scrubBody(body),
+		},
+		Response: CassetteResponse{
+			Status: resp.StatusCode,
+			Header: r.scrubHeader(resp.Header, "Content-Length"),
+			Body:   r.scrubBody(respBody),
+		},
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// match returns the first interaction matching a request which was not
+// replayed yet, or else the last replayed one
+func (r *Recorder) match(req *http.Request, body []byte) *Interaction {
+	key := r.matchKey(req.Method, req.URL, body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	last := -1
+	for i, interaction := range r.cassette.Interactions {
+		u, err := url.Parse(interaction.Request.URL)
+		if err != nil || r.matchKey(interaction.Request.Method, u, interaction.Request.Body) != key {
+			continue
+		}
+		if !r.replayed[i] {
+			r.replayed[i] = true
+			return interaction
+		}
+		last = i
+	}
+	if last < 0 || r.strict {
+		return nil
+	}
+	return r.cassette.Interactions[last]
+}
+
+// matchKey returns the method, path, sorted query and compacted body of a
+// request, scrubbed like recorded requests
+func (r *Recorder) matchKey(method string, u *url.URL, body []byte) string {
+	scrubbed, _ := url.Parse(r.redactor.RedactURL(u))
+	return strings.Join([]string{
+		strings.ToUpper(method),
+		"/" + strings.TrimLeft(u.Path, "/"),
+		scrubbed.Query().Encode(),
+		string(r.scrubBody(body)),
+	}, "\n")
+}
+
+// scrubBody masks the sensitive values of a body and compacts JSON
+func (r *Recorder) scrubBody(body []byte) CassetteBody {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	return r.redactor.RedactBody(body)
+}
+
+// scrubHeader returns a copy of a header with credentials masked and the
+// given headers removed
+func (r *Recorder) scrubHeader(header http.Header, remove ...string) http.Header {
+	scrubbed := header.Clone()
+	for _, name := range remove {
+		scrubbed.Del(name)
+	}
+	for _, name := range cassetteScrubHeaders {
+		if scrubbed.Get(name) != "" {
+			scrubbed.Set(name, "[REDACTED]")
+		}
+	}
+	return scrubbed
+}
+
+// toHTTP returns the recorded response as the response of a request
+func (c CassetteResponse) toHTTP(req *http.Request) *http.Response {
+	header := c.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", c.Status, http.StatusText(c.Status)),
+		StatusCode:    c.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(c.Body)),
+		ContentLength: int64(len(c.Body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,130 @@
+package goshopifytest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	goshopify "github.com/gempages/go-shopify"
+)
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+	customer := goshopify.Customer{Email: "jane@example.com", FirstName: "Jane", Tags: "vip"}
+
+	srv := NewServer(WithAccessToken("shpat_secret"))
+	rec, err := NewRecorder("customers", ModeRecord, WithCassetteDir(dir))
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+	client := srv.NewClient(goshopify.WithHTTPClient(rec.HTTPClient()))
+
+	created, err := client.Customer.Create(ctx, customer)
+	if err != nil {
+		t.Fatalf("Customer.Create returned error: %v", err)
+	}
+	if created.Email != "jane@example.com" {
+		t.Errorf("Customer.Create while recording returned email %q, expected the real email", created.Email)
+	}
+	_, err = client.Customer.List(ctx, goshopify.ListOptions{Limit: 10, SinceID: 0, Fields: "id,tags"})
+	if err != nil {
+		t.Fatalf("Customer.List returned error: %v", err)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("Recorder.Stop returned error: %v", err)
+	}
+	srv.Close()
+
+	data, err := os.ReadFile(rec.Path())
+	if err != nil {
+		t.Fatalf("cassette not written: %v", err)
+	}
+	for _, secret := range []string{"shpat_secret", "jane@example.com", "Jane"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains %q, expected it scrubbed", secret)
+		}
+	}
+
+	rec, err = NewRecorder("customers", ModeReplay, WithCassetteDir(dir), WithStrict())
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+	client = goshopify.NewClient(goshopify.App{}, "fooshop", "another_token", goshopify.WithHTTPClient(rec.HTTPClient()))
+
+	replayed, err := client.Customer.Create(ctx, customer)
+	if err != nil {
+		t.Fatalf("Customer.Create replay returned error: %v", err)
+	}
+	if replayed.ID != created.ID || replayed.Tags != "vip" || replayed.Email != "[REDACTED]" {
+		t.Errorf("Customer.Create replay returned %+v, expected the recorded customer scrubbed", replayed)
+	}
+
+	// the query params are matched regardless of their order
+	req, _ := http.NewRequest("GET", "https://fooshop.myshopify.com/admin/customers.json?limit=10&fields=id,tags", nil)
+	resp, err := rec.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("Recorder.RoundTrip returned %v, %v, expected the recorded list", resp, err)
+	}
+
+	if err := rec.Stop(); err != nil {
+		t.Errorf("Recorder.Stop returned error: %v", err)
+	}
+}
+
+func TestRecorderStrict(t *testing.T) {
+	rec, err := NewRecorder("shop", ModeReplay, WithStrict())
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+	client := goshopify.NewClient(goshopify.App{}, "fooshop", "token",
+		goshopify.WithVersion("2024-01"), goshopify.WithHTTPClient(rec.HTTPClient()))
+	ctx := context.Background()
+
+	_, err = client.Product.List(ctx, nil)
+	if err == nil || !strings.Contains(err.Error(), "no interaction of cassette shop matches GET") {
+		t.Errorf("Product.List returned %v, expected an unmatched request error", err)
+	}
+
+	err = rec.Stop()
+	if err == nil || !strings.Contains(err.Error(), "never replayed: GET https://fooshop.myshopify.com/admin/api/2024-01/shop.json") {
+		t.Errorf("Recorder.Stop returned %v, expected the unused interaction", err)
+	}
+
+	shop, err := client.Shop.Get(ctx, nil)
+	if err != nil {
+		t.Fatalf("Shop.Get returned error: %v", err)
+	}
+	if shop.ID != 690933842 || shop.Name != "Foo Shop" {
+		t.Errorf("Shop.Get returned %+v, expected the recorded shop", shop)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Errorf("Recorder.Stop returned error: %v", err)
+	}
+}
+
+func TestRecorderPassThrough(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	rec, err := NewRecorder("shop", ModeReplay)
+	if err != nil {
+		t.Fatalf("NewRecorder returned error: %v", err)
+	}
+	client := srv.NewClient(goshopify.WithHTTPClient(rec.HTTPClient()))
+
+	count, err := client.Product.Count(context.Background(), nil)
+	if err != nil || count != 0 {
+		t.Errorf("Product.Count returned %d, %v, expected the request sent to the server", count, err)
+	}
+}
+
+func TestNewRecorderMissingCassette(t *testing.T) {
+	_, err := NewRecorder("missing", ModeReplay, WithCassetteDir(t.TempDir()))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("NewRecorder returned %v, expected a not exist error", err)
+	}
+}
@@ -0,0 +1,980 @@
+package goshopifytest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 250
+)
+
+// object is a stored resource as decoded from JSON
+type object = map[string]interface{}
+
+// collection describes how a resource is stored and served
+type collection struct {
+	name     string // e.g. "products"
+	singular string // e.g. "product"
+	gid      string // type of the admin_graphql_api_id, e.g. "Product"
+
+	// parentKey is the field holding the id of the parent of nested
+	// resources, e.g. "product_id" for variants
+	parentKey string
+
+	// children are nested collections embedded in the resource
+	children []string
+
+	// required fields can't be blank, unique fields can't be taken by
+	// another resource of the collection
+	required []string
+	unique   []string
+
+	// filters are the fields lists can be filtered by with query params
+	filters []string
+
+	// handleFrom is the field a missing handle is derived from
+	handleFrom string
+
+	// created is called after the resource and its children are stored
+	created func(s *Server, obj object)
+}
+
+var collections = map[string]*collection{
+	"products": {
+		name: "products", singular: "product", gid: "Product",
+		children:   []string{"variants", "images"},
+		required:   []string{"title"},
+		filters:    []string{"title", "vendor", "handle", "product_type", "status"},
+		handleFrom: "title",
+	},
+	"variants": {
+		name: "variants", singular: "variant", gid: "ProductVariant",
+		parentKey: "product_id",
+	},
+	"images": {
+		name: "images", singular: "image", gid: "ProductImage",
+		parentKey: "product_id",
+	},
+	"orders": {
+		name: "orders", singular: "order", gid: "Order",
+		filters: []string{"financial_status", "fulfillment_status", "email"},
+	},
+	"customers": {
+		name: "customers", singular: "customer", gid: "Customer",
+		unique:  []string{"email"},
+		filters: []string{"email"},
+	},
+	"custom_collections": {
+		name: "custom_collections", singular: "custom_collection", gid: "Collection",
+		required:   []string{"title"},
+		filters:    []string{"title", "handle"},
+		handleFrom: "title",
+	},
+	"smart_collections": {
+		name: "smart_collections", singular: "smart_collection", gid: "Collection",
+		required:   []string{"title"},
+		filters:    []string{"title", "handle"},
+		handleFrom: "title",
+	},
+	"metafields": {
+		name: "metafields", singular: "metafield", gid: "Metafield",
+		required: []string{"namespace", "key"},
+		filters:  []string{"namespace", "key"},
+	},
+	"webhooks": {
+		name: "webhooks", singular: "webhook", gid: "WebhookSubscription",
+		required: []string{"topic", "address"},
+		filters:  []string{"topic", "address"},
+	},
+	"themes": {
+		name: "themes", singular: "theme", gid: "OnlineStoreTheme",
+		required: []string{"name"},
+		filters:  []string{"role"},
+	},
+	"inventory_items": {
+		name: "inventory_items", singular: "inventory_item", gid: "InventoryItem",
+	},
+}
+
+func init() {
+	// set here as the hooks refer to collections themselves
+	collections["products"].created = createDefaultVariant
+	collections["variants"].created = createInventoryItem
+	collections["orders"].created = numberOrder
+}
+
+type route struct {
+	pattern *regexp.Regexp
+	handler func(s *Server, w http.ResponseWriter, r *http.Request, params []string)
+}
+
+const topLevel = `(products|orders|customers|custom_collections|smart_collections|metafields|webhooks|themes|inventory_items)`
+
+// routes are matched in order against the path following the api prefix
+var routes = []route{
+	{regexp.MustCompile(`^shop\.json$`), (*Server).serveShop},
+	{regexp.MustCompile(`^` + topLevel + `\.json$`), (*Server).serveCollection},
+	{regexp.MustCompile(`^` + topLevel + `/count\.json$`), (*Server).serveCount},
+	{regexp.MustCompile(`^orders/(\d+)/(close|open|cancel)\.json$`), (*Server).serveOrderAction},
+	{regexp.MustCompile(`^themes/(\d+)/assets\.json$`), (*Server).serveAssets},
+	{regexp.MustCompile(`^products/(\d+)/(variants|images)\.json$`), (*Server).serveNestedCollection},
+	{regexp.MustCompile(`^products/(\d+)/(variants|images)/count\.json$`), (*Server).serveNestedCount},
+	{regexp.MustCompile(`^products/(\d+)/(variants|images)/(\d+)\.json$`), (*Server).serveNestedMember},
+	{regexp.MustCompile(`^(\w+)/(\d+)/metafields\.json$`), (*Server).serveOwnerMetafields},
+	{regexp.MustCompile(`^(\w+)/(\d+)/metafields/count\.json$`), (*Server).serveOwnerMetafieldsCount},
+	{regexp.MustCompile(`^(\w+)/(\d+)/metafields/(\d+)\.json$`), (*Server).serveOwnerMetafield},
+	{regexp.MustCompile(`^(` + strings.Trim(topLevel, "()") + `|variants)/(\d+)\.json$`), (*Server).serveMember},
+}
+
+// Insert stores a resource of a collection, e.g. "products", as if it was
+// created through the api and returns its id. v is encoded to JSON, nested
+// resources such as the variants of a product are stored as well.
+func (s *Server) Insert(collectionName string, v interface{}) (int64, error) {
+	c, ok := collections[collectionName]
+	if !ok {
+		return 0, fmt.Errorf("goshopifytest: unknown collection %q", collectionName)
+	}
+	obj, err := toObject(v)
+	if err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.insert(c, obj), nil
+}
+
+// Get decodes the stored resource of a collection into v as it would be
+// returned by the api. It returns false if there is no such resource.
+func (s *Server) Get(collectionName string, id int64, v interface{}) (bool, error) {
+	c, ok := collections[collectionName]
+	if !ok {
+		return false, fmt.Errorf("goshopifytest: unknown collection %q", collectionName)
+	}
+
+	s.mu.Lock()
+	obj, ok := s.objects[c.name][id]
+	if ok {
+		obj = s.render(c, obj, nil)
+	}
+	s.mu.Unlock()
+	if !ok {
+		return false, nil
+	}
+
+	js, err := json.Marshal(obj)
+	if err != nil {
+		return false, err
+	}
+	return true, json.Unmarshal(js, v)
+}
+
+// Count returns the number of stored resources of a collection
+func (s *Server) Count(collectionName string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.objects[collectionName])
+}
+
+func (s *Server) serveShop(w http.ResponseWriter, r *http.Request, _ []string) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+		return
+	}
+	domain := s.shopName
+	if !strings.Contains(domain, ".") {
+		domain += ".myshopify.com"
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"shop": object{
+		"id":                     1,
+		"name":                   strings.TrimSuffix(domain, ".myshopify.com"),
+		"domain":                 domain,
+		"myshopify_domain":       domain,
+		"currency":               "USD",
+		"money_format":           "${{amount}}",
+		"plan_name":              "partner_test",
+		"password_enabled":       false,
+		"has_storefront":         true,
+		"setup_required":         false,
+		"checkout_api_supported": true,
+	}})
+}
+
+func (s *Server) serveCollection(w http.ResponseWriter, r *http.Request, params []string) {
+	c := collections[params[0]]
+	switch r.Method {
+	case http.MethodGet:
+		s.list(w, r, c, nil)
+	case http.MethodPost:
+		extra := object{}
+		if c.name == "metafields" {
+			extra["owner_resource"] = "shop"
+			extra["owner_id"] = int64(1)
+		}
+		s.create(w, r, c, extra)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+func (s *Server) serveCount(w http.ResponseWriter, r *http.Request, params []string) {
+	s.count(w, r, collections[params[0]], nil)
+}
+
+func (s *Server) serveMember(w http.ResponseWriter, r *http.Request, params []string) {
+	id, _ := strconv.ParseInt(params[1], 10, 64)
+	s.member(w, r, collections[params[0]], id, nil)
+}
+
+func (s *Server) serveNestedCollection(w http.ResponseWriter, r *http.Request, params []string) {
+	productID, _ := strconv.ParseInt(params[0], 10, 64)
+	if _, ok := s.objects["products"][productID]; !ok {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	c := collections[params[1]]
+	switch r.Method {
+	case http.MethodGet:
+		s.list(w, r, c, childOf(c, productID))
+	case http.MethodPost:
+		s.create(w, r, c, object{c.parentKey: productID})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+func (s *Server) serveNestedCount(w http.ResponseWriter, r *http.Request, params []string) {
+	productID, _ := strconv.ParseInt(params[0], 10, 64)
+	if _, ok := s.objects["products"][productID]; !ok {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	c := collections[params[1]]
+	s.count(w, r, c, childOf(c, productID))
+}
+
+func (s *Server) serveNestedMember(w http.ResponseWriter, r *http.Request, params []string) {
+	productID, _ := strconv.ParseInt(params[0], 10, 64)
+	id, _ := strconv.ParseInt(params[2], 10, 64)
+	c := collections[params[1]]
+	s.member(w, r, c, id, childOf(c, productID))
+}
+
+func (s *Server) serveOwnerMetafields(w http.ResponseWriter, r *http.Request, params []string) {
+	match, ok := s.metafieldOwner(w, params)
+	if !ok {
+		return
+	}
+	c := collections["metafields"]
+	switch r.Method {
+	case http.MethodGet:
+		s.list(w, r, c, match)
+	case http.MethodPost:
+		id, _ := strconv.ParseInt(params[1], 10, 64)
+		s.create(w, r, c, object{"owner_resource": ownerResource(params[0]), "owner_id": id})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+func (s *Server) serveOwnerMetafieldsCount(w http.ResponseWriter, r *http.Request, params []string) {
+	if match, ok := s.metafieldOwner(w, params); ok {
+		s.count(w, r, collections["metafields"], match)
+	}
+}
+
+func (s *Server) serveOwnerMetafield(w http.ResponseWriter, r *http.Request, params []string) {
+	if match, ok := s.metafieldOwner(w, params); ok {
+		id, _ := strconv.ParseInt(params[2], 10, 64)
+		s.member(w, r, collections["metafields"], id, match)
+	}
+}
+
+// metafieldOwner checks that the owner of nested metafields exists if it is
+// stored by the server, and returns a filter for its metafields
+func (s *Server) metafieldOwner(w http.ResponseWriter, params []string) (func(object) bool, bool) {
+	ownerID, _ := strconv.ParseInt(params[1], 10, 64)
+	if _, known := collections[params[0]]; known {
+		if _, ok := s.objects[params[0]][ownerID]; !ok {
+			writeError(w, http.StatusNotFound, "Not Found")
+			return nil, false
+		}
+	}
+	resource := ownerResource(params[0])
+	return func(obj object) bool {
+		return obj["owner_resource"] == resource && toInt64(obj["owner_id"]) == ownerID
+	}, true
+}
+
+// ownerResource returns the owner_resource of metafields nested in a
+// collection, e.g. "product" for "products"
+func ownerResource(collectionName string) string {
+	if c, ok := collections[collectionName]; ok {
+		return c.singular
+	}
+	return strings.TrimSuffix(collectionName, "s")
+}
+
+func (s *Server) serveOrderAction(w http.ResponseWriter, r *http.Request, params []string) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+		return
+	}
+	id, _ := strconv.ParseInt(params[0], 10, 64)
+	c := collections["orders"]
+	obj, ok := s.objects[c.name][id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	now := s.timestamp()
+	switch params[1] {
+	case "close":
+		obj["closed_at"] = now
+	case "open":
+		obj["closed_at"] = nil
+	case "cancel":
+		if obj["cancelled_at"] != nil {
+			writeError(w, http.StatusUnprocessableEntity, object{"base": []string{"Order has already been cancelled"}})
+			return
+		}
+		var payload object
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		reason := "other"
+		if v, ok := payload["reason"].(string); ok && v != "" {
+			reason = v
+		}
+		obj["cancelled_at"] = now
+		obj["cancel_reason"] = reason
+		obj["closed_at"] = now
+	}
+	obj["updated_at"] = now
+	writeJSON(w, http.StatusOK, object{c.singular: s.render(c, obj, nil)})
+}
+
+func (s *Server) serveAssets(w http.ResponseWriter, r *http.Request, params []string) {
+	themeID, _ := strconv.ParseInt(params[0], 10, 64)
+	if _, ok := s.objects["themes"][themeID]; !ok {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	assets := s.assets[themeID]
+	key := r.URL.Query().Get("asset[key]")
+
+	switch r.Method {
+	case http.MethodGet:
+		if key == "" {
+			keys := make([]string, 0, len(assets))
+			for k := range assets {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			list := make([]object, 0, len(keys))
+			for _, k := range keys {
+				asset := copyObject(assets[k])
+				delete(asset, "value")
+				list = append(list, asset)
+			}
+			writeJSON(w, http.StatusOK, object{"assets": list})
+			return
+		}
+		asset, ok := assets[key]
+		if !ok {
+			writeError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		writeJSON(w, http.StatusOK, object{"asset": asset})
+	case http.MethodPut:
+		payload, ok := decodeResource(w, r, "asset")
+		if !ok {
+			return
+		}
+		key, _ := payload["key"].(string)
+		if key == "" {
+			writeError(w, http.StatusUnprocessableEntity, object{"key": []string{"can't be blank"}})
+			return
+		}
+		now := s.timestamp()
+		asset, ok := assets[key]
+		if !ok {
+			asset = object{"key": key, "theme_id": themeID, "created_at": now}
+			if assets == nil {
+				assets = make(map[string]object)
+				s.assets[themeID] = assets
+			}
+			assets[key] = asset
+		}
+		for k, v := range payload {
+			asset[k] = v
+		}
+		if value, ok := asset["value"].(string); ok {
+			asset["size"] = len(value)
+		}
+		asset["updated_at"] = now
+		writeJSON(w, http.StatusOK, object{"asset": asset})
+	case http.MethodDelete:
+		if _, ok := assets[key]; !ok {
+			writeError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		delete(assets, key)
+		writeJSON(w, http.StatusOK, object{"message": fmt.Sprintf("%s was successfully deleted", key)})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+// list writes a page of the resources of a collection matching the filter
+// and the query params, with a Link header to the previous and next pages
+func (s *Server) list(w http.ResponseWriter, r *http.Request, c *collection, match func(object) bool) {
+	q := r.URL.Query()
+	limit := defaultPageLimit
+	if v := q.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			writeError(w, http.StatusBadRequest, object{"limit": "Invalid value."})
+			return
+		}
+		limit = n
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+
+	offset := 0
+	if pageInfo := q.Get("page_info"); pageInfo != "" {
+		cur, err := decodeCursor(pageInfo)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, object{"page_info": "Invalid value."})
+			return
+		}
+		offset = cur.Offset
+		q, _ = url.ParseQuery(cur.Query)
+	}
+
+	items, err := s.filter(c, q, match)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	end := offset + limit
+	if end > len(items) {
+		end = len(items)
+	}
+	if offset > end {
+		offset = end
+	}
+
+	var links []string
+	if offset > 0 {
+		prev := offset - limit
+		if prev < 0 {
+			prev = 0
+		}
+		links = append(links, s.pageLink(r, limit, cursor{Offset: prev, Query: q.Encode()}, "previous"))
+	}
+	if end < len(items) {
+		links = append(links, s.pageLink(r, limit, cursor{Offset: end, Query: q.Encode()}, "next"))
+	}
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+
+	fields := splitList(q.Get("fields"))
+	page := make([]object, 0, end-offset)
+	for _, obj := range items[offset:end] {
+		page = append(page, s.render(c, obj, fields))
+	}
+	writeJSON(w, http.StatusOK, object{c.name: page})
+}
+
+// count writes the number of resources of a collection matching the filter
+// and the query params
+func (s *Server) count(w http.ResponseWriter, r *http.Request, c *collection, match func(object) bool) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+		return
+	}
+	items, err := s.filter(c, r.URL.Query(), match)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, object{"count": len(items)})
+}
+
+// filter returns the resources of a collection matching the filter and the
+// query params ordered by id
+func (s *Server) filter(c *collection, q url.Values, match func(object) bool) ([]object, error) {
+	var ids map[int64]bool
+	if v := q.Get("ids"); v != "" {
+		ids = make(map[int64]bool)
+		for _, s := range splitList(v) {
+			id, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid ids %q", v)
+			}
+			ids[id] = true
+		}
+	}
+	var sinceID int64
+	if v := q.Get("since_id"); v != "" {
+		var err error
+		sinceID, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid since_id %q", v)
+		}
+	}
+
+	var items []object
+	for id, obj := range s.objects[c.name] {
+		if ids != nil && !ids[id] || id <= sinceID {
+			continue
+		}
+		if match != nil && !match(obj) {
+			continue
+		}
+		if c.name == "orders" && !orderHasStatus(obj, q.Get("status")) {
+			continue
+		}
+		matched := true
+		for _, field := range c.filters {
+			if v := q.Get(field); v != "" && v != "any" && fmt.Sprint(obj[field]) != v {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			items = append(items, obj)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return toInt64(items[i]["id"]) < toInt64(items[j]["id"])
+	})
+	return items, nil
+}
+
+// orderHasStatus reports whether an order has the status of the status
+// query param, which defaults to "open" like in Shopify
+func orderHasStatus(obj object, status string) bool {
+	switch status {
+	case "", "open":
+		return obj["closed_at"] == nil && obj["cancelled_at"] == nil
+	case "closed":
+		return obj["closed_at"] != nil
+	case "cancelled":
+		return obj["cancelled_at"] != nil
+	}
+	return true
+}
+
+// member serves the GET, PUT and DELETE requests of a single resource
+func (s *Server) member(w http.ResponseWriter, r *http.Request, c *collection, id int64, match func(object) bool) {
+	obj, ok := s.objects[c.name][id]
+	if !ok || match != nil && !match(obj) {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, object{c.singular: s.render(c, obj, splitList(r.URL.Query().Get("fields")))})
+	case http.MethodPut:
+		payload, ok := decodeResource(w, r, c.singular)
+		if !ok {
+			return
+		}
+		if errs := s.validate(c, payload, id, false); len(errs) > 0 {
+			writeError(w, http.StatusUnprocessableEntity, errs)
+			return
+		}
+		s.update(c, obj, payload)
+		writeJSON(w, http.StatusOK, object{c.singular: s.render(c, obj, nil)})
+	case http.MethodDelete:
+		s.delete(c, id)
+		writeJSON(w, http.StatusOK, object{})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+}
+
+// create serves the POST request creating a resource, extra fields such as
+// the id of the parent are set on the new resource
+func (s *Server) create(w http.ResponseWriter, r *http.Request, c *collection, extra object) {
+	payload, ok := decodeResource(w, r, c.singular)
+	if !ok {
+		return
+	}
+	for k, v := range extra {
+		payload[k] = v
+	}
+	if errs := s.validate(c, payload, 0, true); len(errs) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, errs)
+		return
+	}
+	id := s.insert(c, payload)
+	writeJSON(w, http.StatusCreated, object{c.singular: s.render(c, s.objects[c.name][id], nil)})
+}
+
+// validate returns the errors of the required and unique fields of a
+// resource, keyed by field. Required fields are only checked if they are
+// present when updating.
+func (s *Server) validate(c *collection, obj object, id int64, creating bool) map[string][]string {
+	errs := make(map[string][]string)
+	for _, field := range c.required {
+		v, present := obj[field]
+		if (creating || present) && isBlank(v) {
+			errs[field] = append(errs[field], "can't be blank")
+		}
+	}
+	for _, field := range c.unique {
+		v, present := obj[field]
+		if !present || isBlank(v) {
+			continue
+		}
+		for otherID, other := range s.objects[c.name] {
+			if otherID != id && other[field] == v {
+				errs[field] = append(errs[field], "has already been taken")
+				break
+			}
+		}
+	}
+	if c.name == "webhooks" && creating {
+		for _, other := range s.objects[c.name] {
+			if other["topic"] == obj["topic"] && other["address"] == obj["address"] {
+				errs["address"] = append(errs["address"], "for this topic has already been taken")
+			}
+		}
+	}
+	return errs
+}
+
+// insert stores a new resource with its children and returns its id
+func (s *Server) insert(c *collection, obj object) int64 {
+	s.lastID++
+	id := s.lastID
+	now := s.timestamp()
+
+	children := make(map[string][]interface{})
+	for _, name := range c.children {
+		if list, ok := obj[name].([]interface{}); ok {
+			children[name] = list
+		}
+		delete(obj, name)
+	}
+	metafields, _ := obj["metafields"].([]interface{})
+	if c.name != "metafields" {
+		delete(obj, "metafields")
+	}
+
+	obj["id"] = id
+	obj["created_at"] = now
+	obj["updated_at"] = now
+	obj["admin_graphql_api_id"] = fmt.Sprintf("gid://shopify/%s/%d", c.gid, id)
+	if c.handleFrom != "" && isBlank(obj["handle"]) {
+		obj["handle"] = handleize(fmt.Sprint(obj[c.handleFrom]))
+	}
+	if s.objects[c.name] == nil {
+		s.objects[c.name] = make(map[int64]object)
+	}
+	s.objects[c.name][id] = obj
+
+	for _, name := range c.children {
+		child := collections[name]
+		for _, v := range children[name] {
+			if childObj, ok := v.(object); ok {
+				childObj[child.parentKey] = id
+				s.insert(child, childObj)
+			}
+		}
+	}
+
+	for _, v := range metafields {
+		if mf, ok := v.(object); ok && c.name != "metafields" {
+			mf["owner_resource"] = c.singular
+			mf["owner_id"] = id
+			s.insert(collections["metafields"], mf)
+		}
+	}
+
+	if c.created != nil {
+		c.created(s, obj)
+	}
+	return id
+}
+
+// update merges the fields of a payload into a stored resource. Children in
+// the payload replace the stored children: children with an id are updated,
+// children without one are created and missing ones are deleted.
+func (s *Server) update(c *collection, obj object, payload object) {
+	id := toInt64(obj["id"])
+	for _, name := range c.children {
+		list, ok := payload[name].([]interface{})
+		delete(payload, name)
+		if !ok {
+			continue
+		}
+
+		child := collections[name]
+		keep := make(map[int64]bool)
+		for _, v := range list {
+			childPayload, ok := v.(object)
+			if !ok {
+				continue
+			}
+			childID := toInt64(childPayload["id"])
+			if existing, ok := s.objects[name][childID]; ok && toInt64(existing[child.parentKey]) == id {
+				s.update(child, existing, childPayload)
+				keep[childID] = true
+				continue
+			}
+			delete(childPayload, "id")
+			childPayload[child.parentKey] = id
+			keep[s.insert(child, childPayload)] = true
+		}
+		for childID, existing := range s.objects[name] {
+			if toInt64(existing[child.parentKey]) == id && !keep[childID] {
+				s.delete(child, childID)
+			}
+		}
+	}
+
+	for k, v := range payload {
+		switch k {
+		case "id", "created_at", "admin_graphql_api_id", "metafields", c.parentKey:
+			continue
+		}
+		obj[k] = v
+	}
+	obj["updated_at"] = s.timestamp()
+}
+
+// delete removes a stored resource with its children and metafields
+func (s *Server) delete(c *collection, id int64) {
+	delete(s.objects[c.name], id)
+	for _, name := range c.children {
+		child := collections[name]
+		for childID, obj := range s.objects[name] {
+			if toInt64(obj[child.parentKey]) == id {
+				s.delete(child, childID)
+			}
+		}
+	}
+	for mfID, mf := range s.objects["metafields"] {
+		if mf["owner_resource"] == c.singular && toInt64(mf["owner_id"]) == id {
+			delete(s.objects["metafields"], mfID)
+		}
+	}
+	if c.name == "themes" {
+		delete(s.assets, id)
+	}
+}
+
+// render returns a copy of a stored resource with its children embedded and
+// limited to the given fields if any
+func (s *Server) render(c *collection, obj object, fields []string) object {
+	out := copyObject(obj)
+	id := toInt64(obj["id"])
+	for _, name := range c.children {
+		child := collections[name]
+		items, _ := s.filter(child, nil, childOf(child, id))
+		list := make([]object, 0, len(items))
+		for _, item := range items {
+			list = append(list, s.render(child, item, nil))
+		}
+		out[name] = list
+	}
+	if c.name == "products" {
+		// the image of a product is its first image
+		out["image"] = nil
+		if images, _ := out["images"].([]object); len(images) > 0 {
+			out["image"] = images[0]
+		}
+	}
+	if len(fields) > 0 {
+		projected := make(object, len(fields))
+		for _, f := range fields {
+			if v, ok := out[f]; ok {
+				projected[f] = v
+			}
+		}
+		out = projected
+	}
+	return out
+}
+
+// createDefaultVariant gives products created without variants the
+// "Default Title" variant like Shopify does
+func createDefaultVariant(s *Server, product object) {
+	id := toInt64(product["id"])
+	for _, v := range s.objects["variants"] {
+		if toInt64(v["product_id"]) == id {
+			return
+		}
+	}
+	s.insert(collections["variants"], object{
+		"product_id": id,
+		"title":      "Default Title",
+		"option1":    "Default Title",
+		"price":      "0.00",
+		"position":   1,
+	})
+}
+
+// createInventoryItem creates the inventory item of a new variant
+func createInventoryItem(s *Server, variant object) {
+	if toInt64(variant["inventory_item_id"]) != 0 {
+		return
+	}
+	item := object{"sku": variant["sku"], "tracked": false, "requires_shipping": true}
+	variant["inventory_item_id"] = s.insert(collections["inventory_items"], item)
+}
+
+// numberOrder sets the number and name of a new order, starting at #1001
+func numberOrder(s *Server, order object) {
+	if toInt64(order["number"]) != 0 {
+		return
+	}
+	n := int64(len(s.objects["orders"]))
+	order["number"] = n
+	order["order_number"] = 1000 + n
+	order["name"] = fmt.Sprintf("#%d", 1000+n)
+	if _, ok := order["financial_status"]; !ok {
+		order["financial_status"] = "pending"
+	}
+	order["closed_at"] = nil
+	order["cancelled_at"] = nil
+}
+
+// childOf returns a filter for the children of a parent resource
+func childOf(c *collection, parentID int64) func(object) bool {
+	return func(obj object) bool {
+		return toInt64(obj[c.parentKey]) == parentID
+	}
+}
+
+// decodeResource decodes the resource wrapped in a key of the request body,
+// e.g. {"product": {...}}, writing a 400 response if it is missing
+func decodeResource(w http.ResponseWriter, r *http.Request, key string) (object, bool) {
+	var payload map[string]object
+	decoder := json.NewDecoder(r.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&payload); err != nil || payload[key] == nil {
+		writeError(w, http.StatusBadRequest, object{key: "Required parameter missing or invalid"})
+		return nil, false
+	}
+	return payload[key], true
+}
+
+// toObject encodes v to JSON and decodes it into an object
+func toObject(v interface{}) (object, error) {
+	js, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var obj object
+	decoder := json.NewDecoder(bytes.NewReader(js))
+	decoder.UseNumber()
+	if err := decoder.Decode(&obj); err != nil {
+		return nil, fmt.Errorf("goshopifytest: %T is not a JSON object: %w", v, err)
+	}
+	return obj, nil
+}
+
+func copyObject(obj object) object {
+	out := make(object, len(obj))
+	for k, v := range obj {
+		out[k] = v
+	}
+	return out
+}
+
+func toInt64(v interface{}) int64 {
+	switch n := v.(type) {
+	case int64:
+		return n
+	case int:
+		return int64(n)
+	case float64:
+		return int64(n)
+	case json.Number:
+		i, _ := n.Int64()
+		return i
+	case string:
+		i, _ := strconv.ParseInt(n, 10, 64)
+		return i
+	}
+	return 0
+}
+
+func isBlank(v interface{}) bool {
+	switch s := v.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(s) == ""
+	}
+	return false
+}
+
+var nonHandleRegex = regexp.MustCompile(`[^a-z0-9]+`)
+
+// handleize derives a handle from a title, e.g. "Blue Shirt" -> "blue-shirt"
+func handleize(title string) string {
+	return strings.Trim(nonHandleRegex.ReplaceAllString(strings.ToLower(title), "-"), "-")
+}
+
+func splitList(v string) []string {
+	if v == "" {
+		return nil
+	}
+	var out []string
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func (s *Server) timestamp() string {
+	return s.now().UTC().Format(time.RFC3339)
+}
+
+// cursor is the state of a page encoded in page_info
+type cursor struct {
+	Offset int    `json:"o"`
+	Query  string `json:"q"`
+}
+
+func decodeCursor(pageInfo string) (cursor, error) {
+	var cur cursor
+	js, err := base64.RawURLEncoding.DecodeString(pageInfo)
+	if err != nil {
+		return cur, err
+	}
+	err = json.Unmarshal(js, &cur)
+	return cur, err
+}
+
+// pageLink returns a Link header entry to another page of a list
+func (s *Server) pageLink(r *http.Request, limit int, cur cursor, rel string) string {
+	js, _ := json.Marshal(cur)
+	q := url.Values{}
+	q.Set("limit", strconv.Itoa(limit))
+	q.Set("page_info", base64.RawURLEncoding.EncodeToString(js))
+	return fmt.Sprintf(`<%s%s?%s>; rel="%s"`, s.URL, r.URL.Path, q.Encode(), rel)
+}
@@ -0,0 +1,309 @@
+// Package goshopifytest provides a stateful in-memory fake of the Shopify
+// Admin REST API for integration tests of code using goshopify.
+//
+// The server keeps the products (with their variants and images), orders,
+// customers, custom and smart collections, metafields, webhooks, themes with
+// their assets and inventory items created through it. It issues ids,
+// paginates lists with Link headers, reports the api call limit and can be
+// told to fail requests with faults:
+//
+//	srv := goshopifytest.NewServer()
+//	defer srv.Close()
+//
+//	client := srv.NewClient(goshopify.WithRetry(3))
+//	srv.InjectFault(goshopifytest.Fault{Path: "products", Status: 503, Times: 1})
+//	product, err := client.Product.Create(ctx, goshopify.Product{Title: "Shirt"})
+package goshopifytest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	goshopify "github.com/gempages/go-shopify"
+)
+
+const (
+	// DefaultShopName is the name of the shop served unless WithShopName is used
+	DefaultShopName = "fooshop"
+
+	// DefaultAPIVersion is reported for requests without a version in
+	// their path unless WithAPIVersion is used
+	DefaultAPIVersion = "2024-01"
+
+	// DefaultBucketSize is the size of the leaky bucket of the call limit
+	DefaultBucketSize = 40
+
+	// DefaultLeakRate is the number of requests leaking out of the bucket
+	// per second
+	DefaultLeakRate = 2.0
+)
+
+// pathRegex splits a request path into the api version and the resource path
+var pathRegex = regexp.MustCompile(`^/admin/(?:api/([^/]+)/)?(.+)$`)
+
+// Option is used to configure a Server
+type Option func(s *Server)
+
+// WithShopName sets the name of the shop, it is used by Server.NewClient
+func WithShopName(shopName string) Option {
+	return func(s *Server) {
+		s.shopName = shopName
+	}
+}
+
+// WithAccessToken makes the server reject requests without the given
+// X-Shopify-Access-Token with 401 Unauthorized. By default any token is
+// accepted.
+func WithAccessToken(token string) Option {
+	return func(s *Server) {
+		s.token = token
+	}
+}
+
+// WithAPIVersion sets the api version reported for requests without a
+// version in their path
+func WithAPIVersion(apiVersion string) Option {
+	return func(s *Server) {
+		s.apiVersion = apiVersion
+	}
+}
+
+// WithCallLimit enforces the call limit, requests exceeding a bucket of the
+// given size leaking leakRate requests per second are answered with 429 Too
+// Many Requests. Without this option the call limit header is reported but
+// never enforced.
+func WithCallLimit(bucketSize int, leakRate float64) Option {
+	return func(s *Server) {
+		s.bucketSize = bucketSize
+		s.leakRate = leakRate
+		s.enforceCallLimit = true
+	}
+}
+
+// Fault makes the server answer matching requests with an error status
+// instead of serving them
+type Fault struct {
+	// Method of the requests to fail, any method if empty
+	Method string
+
+	// Path prefix of the requests to fail without the api prefix, e.g.
+	// "products" fails "products.json" and "products/1/images.json". Any
+	// path if empty.
+	Path string
+
+	// Status code of the failed responses, e.g. 429 or 503
+	Status int
+
+	// RetryAfter is sent in the Retry-After header of failed responses if
+	// set
+	RetryAfter time.Duration
+
+	// Times is the number of requests to fail, 0 fails all matching
+	// requests until ClearFaults is called
+	Times int
+}
+
+// Request is a request received by the server
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Server is a fake Shopify Admin REST API listening on a local address, see
+// NewServer
+type Server struct {
+	*httptest.Server
+
+	shopName         string
+	token            string
+	apiVersion       string
+	bucketSize       int
+	leakRate         float64
+	enforceCallLimit bool
+
+	mu       sync.Mutex
+	lastID   int64
+	lastReq  int64
+	objects  map[string]map[int64]object
+	assets   map[int64]map[string]object
+	faults   []*Fault
+	requests []Request
+	bucket   float64
+	leakedAt time.Time
+	now      func() time.Time
+}
+
+// NewServer starts and returns a new Server, it should be closed with Close
+// when finished
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		shopName:   DefaultShopName,
+		apiVersion: DefaultAPIVersion,
+		bucketSize: DefaultBucketSize,
+		leakRate:   DefaultLeakRate,
+		objects:    make(map[string]map[int64]object),
+		assets:     make(map[int64]map[string]object),
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// NewClient returns a goshopify client sending its requests to the server
+func (s *Server) NewClient(opts ...goshopify.Option) *goshopify.Client {
+	opts = append([]goshopify.Option{goshopify.WithBaseURL(s.URL)}, opts...)
+	return goshopify.NewClient(goshopify.App{}, s.shopName, s.token, opts...)
+}
+
+// InjectFault adds a fault, faults are matched in the order they were added
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes all faults
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// Requests returns the requests received so far, including failed ones
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+
+	s.lastReq++
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("X-Request-Id", fmt.Sprintf("fake-%d", s.lastReq))
+
+	match := pathRegex.FindStringSubmatch(r.URL.Path)
+	if match == nil {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	version, resourcePath := match[1], match[2]
+	if version == "" {
+		version = s.apiVersion
+	}
+	w.Header().Set("X-Shopify-API-Version", version)
+
+	if s.token != "" && r.Header.Get("X-Shopify-Access-Token") != s.token {
+		writeError(w, http.StatusUnauthorized, "[API] Invalid API key or access token (unrecognized login or wrong password)")
+		return
+	}
+
+	if fault := s.matchFault(r.Method, resourcePath); fault != nil {
+		if fault.RetryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.FormatFloat(fault.RetryAfter.Seconds(), 'f', 1, 64))
+		}
+		writeError(w, fault.Status, http.StatusText(fault.Status))
+		return
+	}
+
+	if !s.takeCall(w) {
+		writeError(w, http.StatusTooManyRequests, "Exceeded 2 calls per second for api client. Reduce request rates to resume uninterrupted service.")
+		return
+	}
+
+	for _, rt := range routes {
+		if m := rt.pattern.FindStringSubmatch(resourcePath); m != nil {
+			rt.handler(s, w, r, m[1:])
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "Not Found")
+}
+
+// matchFault returns the first fault matching a request and uses it up
+func (s *Server) matchFault(method, resourcePath string) *Fault {
+	for i, fault := range s.faults {
+		if fault.Method != "" && !strings.EqualFold(fault.Method, method) {
+			continue
+		}
+		if !strings.HasPrefix(resourcePath, fault.Path) {
+			continue
+		}
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return fault
+	}
+	return nil
+}
+
+// takeCall adds a request to the leaky bucket and sets the call limit
+// header. It returns false and sets Retry-After if the request exceeds an
+// enforced call limit.
+func (s *Server) takeCall(w http.ResponseWriter) bool {
+	now := s.now()
+	if !s.leakedAt.IsZero() {
+		s.bucket = math.Max(0, s.bucket-now.Sub(s.leakedAt).Seconds()*s.leakRate)
+	}
+	s.leakedAt = now
+
+	if s.enforceCallLimit && s.bucket+1 > float64(s.bucketSize) {
+		wait := (s.bucket + 1 - float64(s.bucketSize)) / s.leakRate
+		w.Header().Set("Retry-After", strconv.FormatFloat(math.Ceil(wait), 'f', 1, 64))
+		return false
+	}
+
+	s.bucket++
+	used := int(math.Ceil(s.bucket))
+	if used > s.bucketSize {
+		used = s.bucketSize
+	}
+	w.Header().Set("X-Shopify-Shop-Api-Call-Limit", fmt.Sprintf("%d/%d", used, s.bucketSize))
+	return true
+}
+
+// writeJSON writes v as the JSON body of a response
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error response the way Shopify does, with the
+// message or the map of invalid fields in "errors"
+func writeError(w http.ResponseWriter, status int, errors interface{}) {
+	writeJSON(w, status, map[string]interface{}{"errors": errors})
+}
@@ -0,0 +1,365 @@
+package goshopifytest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	goshopify "github.com/gempages/go-shopify"
+	"github.com/shopspring/decimal"
+)
+
+func TestServerProducts(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.NewClient()
+	ctx := context.Background()
+
+	price := decimal.RequireFromString("10.00")
+	product, err := client.Product.Create(ctx, goshopify.Product{
+		Title: "Blue Shirt",
+		Variants: []goshopify.Variant{
+			{Title: "S", Sku: "SHIRT-S", Price: &price},
+			{Title: "M", Sku: "SHIRT-M", Price: &price},
+		},
+		Images:     []goshopify.Image{{Src: "https://cdn.example.com/shirt.png"}},
+		Metafields: []goshopify.Metafield{{Namespace: "custom", Key: "fabric", Value: "cotton", ValueType: "string"}},
+	})
+	if err != nil {
+		t.Fatalf("Product.Create returned error: %v", err)
+	}
+	if product.ID == 0 || product.Handle != "blue-shirt" || product.CreatedAt == nil {
+		t.Errorf("Product.Create returned %+v, expected an id, handle and created_at", product)
+	}
+	if len(product.Variants) != 2 || product.Variants[0].ProductID != product.ID || product.Variants[0].InventoryItemId == 0 {
+		t.Errorf("Product.Create returned variants %+v, expected 2 variants of the product with inventory items", product.Variants)
+	}
+	if product.Image.Src != "https://cdn.example.com/shirt.png" {
+		t.Errorf("Product.Image returned %+v, expected the first image", product.Image)
+	}
+
+	metafields, err := client.Product.ListMetafields(ctx, product.ID, nil)
+	if err != nil || len(metafields) != 1 || metafields[0].Key != "fabric" {
+		t.Errorf("Product.ListMetafields returned %+v, %v, expected the fabric metafield", metafields, err)
+	}
+
+	item, err := client.InventoryItem.Get(ctx, product.Variants[0].InventoryItemId, nil)
+	if err != nil || item.SKU != "SHIRT-S" {
+		t.Errorf("InventoryItem.Get returned %+v, %v, expected sku SHIRT-S", item, err)
+	}
+
+	variant, err := client.Variant.Update(ctx, goshopify.Variant{ID: product.Variants[1].ID, Sku: "SHIRT-L"})
+	if err != nil || variant.Sku != "SHIRT-L" || variant.Title != "M" {
+		t.Errorf("Variant.Update returned %+v, %v, expected the updated sku only", variant, err)
+	}
+
+	updated, err := client.Product.Update(ctx, goshopify.Product{
+		ID:       product.ID,
+		Vendor:   "Acme",
+		Variants: []goshopify.Variant{{ID: product.Variants[0].ID}, {Title: "XL"}},
+	})
+	if err != nil {
+		t.Fatalf("Product.Update returned error: %v", err)
+	}
+	if updated.Title != "Blue Shirt" || updated.Vendor != "Acme" {
+		t.Errorf("Product.Update returned %+v, expected the title kept and the vendor set", updated)
+	}
+	titles := []string{updated.Variants[0].Title, updated.Variants[1].Title}
+	if !reflect.DeepEqual(titles, []string{"S", "XL"}) {
+		t.Errorf("Product.Update returned variants %v, expected [S XL]", titles)
+	}
+
+	count, err := client.Variant.Count(ctx, product.ID, nil)
+	if err != nil || count != 2 {
+		t.Errorf("Variant.Count returned %d, %v, expected 2", count, err)
+	}
+
+	err = client.Product.Delete(ctx, product.ID)
+	if err != nil {
+		t.Fatalf("Product.Delete returned error: %v", err)
+	}
+	_, err = client.Product.Get(ctx, product.ID, nil)
+	var respErr goshopify.ResponseError
+	if !errors.As(err, &respErr) || respErr.Status != http.StatusNotFound {
+		t.Errorf("Product.Get of a deleted product returned %v, expected 404", err)
+	}
+	if n := srv.Count("variants") + srv.Count("images") + srv.Count("metafields"); n != 0 {
+		t.Errorf("Server kept %d children of the deleted product, expected 0", n)
+	}
+}
+
+func TestServerDefaultVariant(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	id, err := srv.Insert("products", goshopify.Product{Title: "Mug"})
+	if err != nil {
+		t.Fatalf("Server.Insert returned error: %v", err)
+	}
+
+	var product goshopify.Product
+	found, err := srv.Get("products", id, &product)
+	if err != nil || !found {
+		t.Fatalf("Server.Get returned %v, %v, expected the product", found, err)
+	}
+	if len(product.Variants) != 1 || product.Variants[0].Title != "Default Title" {
+		t.Errorf("Server.Get returned variants %+v, expected the default variant", product.Variants)
+	}
+}
+
+func TestServerValidation(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.NewClient()
+	ctx := context.Background()
+
+	_, err := client.Product.Create(ctx, goshopify.Product{Vendor: "Acme"})
+	var respErr goshopify.ResponseError
+	if !errors.As(err, &respErr) || respErr.Status != http.StatusUnprocessableEntity {
+		t.Fatalf("Product.Create without title returned %v, expected 422", err)
+	}
+	if !reflect.DeepEqual(respErr.Errors, []string{"title: can't be blank"}) {
+		t.Errorf("Product.Create errors %v, expected [title: can't be blank]", respErr.Errors)
+	}
+
+	_, err = client.Customer.Create(ctx, goshopify.Customer{Email: "jane@example.com"})
+	if err != nil {
+		t.Fatalf("Customer.Create returned error: %v", err)
+	}
+	_, err = client.Customer.Create(ctx, goshopify.Customer{Email: "jane@example.com"})
+	if !errors.As(err, &respErr) || respErr.Status != http.StatusUnprocessableEntity {
+		t.Errorf("Customer.Create with a taken email returned %v, expected 422", err)
+	}
+}
+
+func TestServerPagination(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.NewClient()
+	ctx := context.Background()
+
+	var ids []int64
+	for i := 0; i < 5; i++ {
+		id, err := srv.Insert("products", goshopify.Product{Title: "Product", Vendor: "Acme"})
+		if err != nil {
+			t.Fatalf("Server.Insert returned error: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	_, err := srv.Insert("products", goshopify.Product{Title: "Other", Vendor: "Other"})
+	if err != nil {
+		t.Fatalf("Server.Insert returned error: %v", err)
+	}
+
+	var got []int64
+	options := &goshopify.ProductListOptions{ListOptions: goshopify.ListOptions{Limit: 2}, Vendor: "Acme"}
+	var pages int
+	var prev *goshopify.ListOptions
+	for {
+		products, pagination, err := client.Product.ListWithPagination(ctx, options)
+		if err != nil {
+			t.Fatalf("Product.ListWithPagination returned error: %v", err)
+		}
+		pages++
+		for _, p := range products {
+			got = append(got, p.ID)
+		}
+		prev = pagination.PreviousPageOptions
+		if pagination.NextPageOptions == nil {
+			break
+		}
+		options = &goshopify.ProductListOptions{ListOptions: *pagination.NextPageOptions}
+	}
+
+	if pages != 3 || !reflect.DeepEqual(got, ids) {
+		t.Errorf("Product.ListWithPagination returned %v in %d pages, expected %v in 3 pages", got, pages, ids)
+	}
+	if prev == nil || prev.PageInfo == "" || prev.Limit != 2 {
+		t.Errorf("PreviousPageOptions of the last page = %+v, expected a page_info and limit 2", prev)
+	}
+
+	count, err := client.Product.Count(ctx, goshopify.ProductListOptions{Vendor: "Acme"})
+	if err != nil || count != 5 {
+		t.Errorf("Product.Count returned %d, %v, expected 5", count, err)
+	}
+
+	products, err := client.Product.List(ctx, goshopify.ListOptions{IDs: ids[3:], Fields: "id,title"})
+	if err != nil || len(products) != 2 || products[0].ID != ids[3] || products[0].Vendor != "" {
+		t.Errorf("Product.List by ids returned %+v, %v, expected 2 products with id and title only", products, err)
+	}
+}
+
+func TestServerOrders(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.NewClient()
+	ctx := context.Background()
+
+	order, err := client.Order.Create(ctx, goshopify.Order{Email: "jane@example.com"})
+	if err != nil {
+		t.Fatalf("Order.Create returned error: %v", err)
+	}
+	if order.Name != "#1001" || order.OrderNumber != 1001 {
+		t.Errorf("Order.Create returned name %q number %d, expected #1001", order.Name, order.OrderNumber)
+	}
+	other, err := client.Order.Create(ctx, goshopify.Order{Email: "john@example.com"})
+	if err != nil {
+		t.Fatalf("Order.Create returned error: %v", err)
+	}
+
+	closed, err := client.Order.Close(ctx, order.ID)
+	if err != nil || closed.ClosedAt == nil {
+		t.Fatalf("Order.Close returned %+v, %v, expected closed_at", closed, err)
+	}
+
+	open, err := client.Order.List(ctx, nil)
+	if err != nil || len(open) != 1 || open[0].ID != other.ID {
+		t.Errorf("Order.List returned %+v, %v, expected the open order only", open, err)
+	}
+
+	cancelled, err := client.Order.Cancel(ctx, other.ID, goshopify.OrderCancelOptions{Reason: "customer"})
+	if err != nil || cancelled.CancelledAt == nil || cancelled.CancelReason != "customer" {
+		t.Errorf("Order.Cancel returned %+v, %v, expected cancelled by the customer", cancelled, err)
+	}
+
+	all, err := client.Order.Count(ctx, goshopify.OrderListOptions{Status: "any"})
+	if err != nil || all != 2 {
+		t.Errorf("Order.Count returned %d, %v, expected 2", all, err)
+	}
+}
+
+func TestServerThemeAssets(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.NewClient()
+	ctx := context.Background()
+
+	theme, err := client.Theme.Create(ctx, goshopify.Theme{Name: "Dawn", Role: "main"})
+	if err != nil {
+		t.Fatalf("Theme.Create returned error: %v", err)
+	}
+
+	_, err = client.Asset.Update(ctx, theme.ID, goshopify.Asset{Key: "templates/index.liquid", Value: "hello"})
+	if err != nil {
+		t.Fatalf("Asset.Update returned error: %v", err)
+	}
+
+	asset, err := client.Asset.Get(ctx, theme.ID, "templates/index.liquid")
+	if err != nil || asset.Value != "hello" || asset.Size != 5 {
+		t.Errorf("Asset.Get returned %+v, %v, expected the stored value", asset, err)
+	}
+
+	assets, err := client.Asset.List(ctx, theme.ID, nil)
+	if err != nil || len(assets) != 1 || assets[0].Value != "" {
+		t.Errorf("Asset.List returned %+v, %v, expected one asset without value", assets, err)
+	}
+
+	err = client.Asset.Delete(ctx, theme.ID, "templates/index.liquid")
+	if err != nil {
+		t.Errorf("Asset.Delete returned error: %v", err)
+	}
+	_, err = client.Asset.Get(ctx, theme.ID, "templates/index.liquid")
+	if err == nil {
+		t.Errorf("Asset.Get of a deleted asset returned no error")
+	}
+}
+
+func TestServerWebhooks(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.NewClient()
+	ctx := context.Background()
+
+	webhook := goshopify.Webhook{Topic: "orders/create", Address: "https://example.com/hook", Format: "json"}
+	created, err := client.Webhook.Create(ctx, webhook)
+	if err != nil {
+		t.Fatalf("Webhook.Create returned error: %v", err)
+	}
+	_, err = client.Webhook.Create(ctx, webhook)
+	if err == nil {
+		t.Errorf("Webhook.Create of a duplicate webhook returned no error")
+	}
+
+	webhooks, err := client.Webhook.List(ctx, goshopify.WebhookOptions{Topic: "orders/create"})
+	if err != nil || len(webhooks) != 1 || webhooks[0].ID != created.ID {
+		t.Errorf("Webhook.List returned %+v, %v, expected the created webhook", webhooks, err)
+	}
+}
+
+func TestServerCallLimit(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	srv := NewServer(WithCallLimit(2, 1))
+	defer srv.Close()
+	srv.now = func() time.Time { return now }
+	client := srv.NewClient()
+	ctx := context.Background()
+
+	for i := 1; i <= 2; i++ {
+		if _, err := client.Product.Count(ctx, nil); err != nil {
+			t.Fatalf("Product.Count returned error: %v", err)
+		}
+		if client.RateLimits.RequestCount != i || client.RateLimits.BucketSize != 2 {
+			t.Errorf("RateLimits = %+v, expected %d/2", client.RateLimits, i)
+		}
+	}
+
+	_, err := client.Product.Count(ctx, nil)
+	if err == nil || !strings.Contains(err.Error(), "Exceeded 2 calls per second") {
+		t.Errorf("Product.Count over the call limit returned %v, expected 429", err)
+	}
+
+	now = now.Add(time.Second)
+	if _, err := client.Product.Count(ctx, nil); err != nil {
+		t.Errorf("Product.Count after a leak returned error: %v", err)
+	}
+}
+
+func TestServerFaults(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.NewClient(goshopify.WithRetry(3))
+	ctx := context.Background()
+
+	srv.InjectFault(Fault{Method: "POST", Path: "products", Status: http.StatusServiceUnavailable, Times: 2})
+	_, err := client.Product.Create(ctx, goshopify.Product{Title: "Retried"})
+	if err != nil {
+		t.Fatalf("Product.Create returned error: %v", err)
+	}
+	if n := len(srv.Requests()); n != 3 {
+		t.Errorf("Server received %d requests, expected 3", n)
+	}
+	if n := srv.Count("products"); n != 1 {
+		t.Errorf("Server stored %d products, expected 1", n)
+	}
+
+	srv.InjectFault(Fault{Path: "orders", Status: http.StatusTooManyRequests})
+	_, err = srv.NewClient().Order.List(ctx, nil)
+	if err == nil || !strings.Contains(err.Error(), "Too Many Requests") {
+		t.Errorf("Order.List returned %v, expected a rate limit error", err)
+	}
+
+	srv.ClearFaults()
+	if _, err := client.Order.List(ctx, nil); err != nil {
+		t.Errorf("Order.List after ClearFaults returned error: %v", err)
+	}
+}
+
+func TestServerAccessToken(t *testing.T) {
+	srv := NewServer(WithAccessToken("shpat_secret"))
+	defer srv.Close()
+	ctx := context.Background()
+
+	if _, err := srv.NewClient().Shop.Get(ctx, nil); err != nil {
+		t.Errorf("Shop.Get returned error: %v", err)
+	}
+
+	client := goshopify.NewClient(goshopify.App{}, DefaultShopName, "wrong", goshopify.WithBaseURL(srv.URL))
+	_, err := client.Shop.Get(ctx, nil)
+	if err == nil || !strings.Contains(err.Error(), "Invalid API key or access token") {
+		t.Errorf("Shop.Get with a wrong token returned %v, expected 401", err)
+	}
+}
@@ -10,12 +10,16 @@ import (
 
 const inventoryItemsBasePath = "inventory_items"
 
+// inventoryItemsMaxIDs is the maximum number of ids of an inventory items list
+const inventoryItemsMaxIDs = 100
+
 // InventoryItemService is an interface for interacting with the
 // inventory items endpoints of the Shopify API
 // See https://help.shopify.com/en/api/reference/inventory/inventoryitem
 type InventoryItemService interface {
 	List(context.Context, interface{}) ([]InventoryItem, error)
 	Get(context.Context, int64, interface{}) (*InventoryItem, error)
+	GetMany(context.Context, []int64) (*GetManyResult[InventoryItem], error)
 	Update(context.Context, InventoryItem) (*InventoryItem, error)
 }
 
@@ -61,6 +65,14 @@ func (s *InventoryItemServiceOp) Get(ctx context.Context, id int64, options inte
 	return resource.InventoryItem, err
 }
 
+// GetMany gets inventory items by id, in chunks of 100 ids
+func (s *InventoryItemServiceOp) GetMany(ctx context.Context, ids []int64) (*GetManyResult[InventoryItem], error) {
+	path := fmt.Sprintf("%s.json", inventoryItemsBasePath)
+	return getMany(ctx, s.client, path, "inventory_items", ids, inventoryItemsMaxIDs, "", func(resource InventoryItem) int64 {
+		return resource.ID
+	})
+}
+
 // Update a inventory item
 func (s *InventoryItemServiceOp) Update(ctx context.Context, item InventoryItem) (*InventoryItem, error) {
 	path := fmt.Sprintf("%s/%d.json", inventoryItemsBasePath, item.ID)
@@ -22,6 +22,13 @@ type LeveledLoggerInterface interface {
 	Warnf(format string, v ...interface{})
 }
 
+// DebugEnabledLogger is implemented by loggers which can report whether they
+// emit debug messages. The client skips reading request and response bodies
+// for debug logs when DebugEnabled returns false.
+type DebugEnabledLogger interface {
+	DebugEnabled() bool
+}
+
 // It prints warnings and errors to `os.Stderr` and other messages to
 // `os.Stdout`.
 type LeveledLogger struct {
@@ -39,6 +46,11 @@ type LeveledLogger struct {
 	stdoutOverride io.Writer
 }
 
+// DebugEnabled reports whether debug messages are emitted.
+func (l *LeveledLogger) DebugEnabled() bool {
+	return l.Level >= LevelDebug
+}
+
 // Debugf logs a debug message using Printf conventions.
 func (l *LeveledLogger) Debugf(format string, v ...interface{}) {
 	if l.Level >= LevelDebug {
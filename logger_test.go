@@ -2,7 +2,9 @@ package goshopify
 
 import (
 	"bytes"
+	"io"
 	"io/ioutil"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
@@ -122,3 +124,108 @@ func TestDoGetHeadersDebug(t *testing.T) {
 		t.Errorf("doGetHeadersDebug expected stdout \"%s\" received \"%s\"", resExpected, out.String())
 	}
 }
+
+// countingReadCloser counts the bytes read from it
+type countingReadCloser struct {
+	io.Reader
+	read int
+}
+
+func (r *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.read += n
+	return n, err
+}
+
+func (r *countingReadCloser) Close() error {
+	return nil
+}
+
+func TestLogBodySkippedWhenDebugDisabled(t *testing.T) {
+	out := &bytes.Buffer{}
+	client := NewClient(app, "fooshop", "abcd", WithLogger(&LeveledLogger{Level: LevelInfo, stdoutOverride: out}))
+
+	body := &countingReadCloser{Reader: strings.NewReader("response body")}
+	res := &http.Response{StatusCode: http.StatusOK, Body: body}
+	client.logResponse(res)
+
+	if body.read != 0 {
+		t.Errorf("logResponse read %d body bytes with debug disabled, expected 0", body.read)
+	}
+	if res.Body != body {
+		t.Errorf("logResponse replaced the body with debug disabled")
+	}
+	if out.String() != "" {
+		t.Errorf("logResponse expected empty log output received \"%s\"", out.String())
+	}
+}
+
+func TestLogBodyLimit(t *testing.T) {
+	out := &bytes.Buffer{}
+	client := NewClient(app, "fooshop", "abcd",
+		WithLogger(&LeveledLogger{Level: LevelDebug, stdoutOverride: out}),
+		WithLogBodyLimit(4))
+
+	body := &countingReadCloser{Reader: strings.NewReader("response body")}
+	res := &http.Response{StatusCode: http.StatusOK, Status: "OK", Body: body}
+	client.logResponse(res)
+
+	expected := "[DEBUG] RECV 200: OK\n[DEBUG] RESP: resp...\n"
+	if out.String() != expected {
+		t.Errorf("logResponse expected stdout \"%s\" received \"%s\"", expected, out.String())
+	}
+	if body.read > 5 {
+		t.Errorf("logResponse buffered %d body bytes, expected at most 5", body.read)
+	}
+
+	rest, _ := io.ReadAll(res.Body)
+	if string(rest) != "response body" {
+		t.Errorf("logResponse body read after logging = \"%s\", expected \"response body\"", rest)
+	}
+}
+
+func TestLogBodyLimitRedacted(t *testing.T) {
+	out := &bytes.Buffer{}
+	client := NewClient(app, "fooshop", "abcd",
+		WithLogger(&LeveledLogger{Level: LevelDebug, stdoutOverride: out}),
+		WithLogBodyLimit(8),
+		WithRedactor(NewRedactor()))
+
+	body := ioutil.NopCloser(strings.NewReader(`{"email":"bob@example.com"}`))
+	client.logBody(&body, "RESP: %s")
+
+	expected := "[DEBUG] RESP: <body over 8 bytes not logged>\n"
+	if out.String() != expected {
+		t.Errorf("logBody expected stdout \"%s\" received \"%s\"", expected, out.String())
+	}
+}
+
+func TestDebugEnabled(t *testing.T) {
+	cases := []struct {
+		logger   LeveledLoggerInterface
+		expected bool
+	}{
+		{&LeveledLogger{}, false},
+		{&LeveledLogger{Level: LevelInfo}, false},
+		{&LeveledLogger{Level: LevelDebug}, true},
+		{NewSlogLogger(slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelInfo}))), false},
+		{NewSlogLogger(slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelDebug}))), true},
+		// loggers which can't report their level get the bodies
+		{&testLogger{}, true},
+	}
+
+	for _, c := range cases {
+		client := NewClient(app, "fooshop", "abcd", WithLogger(c.logger))
+		if client.debugEnabled() != c.expected {
+			t.Errorf("debugEnabled() with %T = %v, expected %v", c.logger, client.debugEnabled(), c.expected)
+		}
+	}
+}
+
+// testLogger is a LeveledLoggerInterface which doesn't implement DebugEnabledLogger
+type testLogger struct{}
+
+func (testLogger) Debugf(string, ...interface{}) {}
+func (testLogger) Errorf(string, ...interface{}) {}
+func (testLogger) Infof(string, ...interface{})  {}
+func (testLogger) Warnf(string, ...interface{})  {}
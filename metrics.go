@@ -0,0 +1,289 @@
+package goshopify
+
+import (
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultMetricsSampleSize is the number of latency samples MemoryMetrics
+// keeps per shop and endpoint to compute percentiles
+const defaultMetricsSampleSize = 1000
+
+// apiPrefixRegex matches the api prefix of a request path, e.g.
+// "admin/api/2024-01/"
+var apiPrefixRegex = regexp.MustCompile(`^(admin/)?(api/[^/]+/)?`)
+
+// RequestMetrics describes the API usage of a single request attempt
+type RequestMetrics struct {
+	// Shop is the host of the shop, e.g. "fooshop.myshopify.com"
+	Shop string
+
+	// Service is the top level resource of the request, e.g. "products" for
+	// both "products.json" and "products/1/variants.json"
+	Service string
+
+	// Path is the request path including the api prefix, e.g.
+	// "admin/api/2024-01/products/1.json"
+	Path string
+
+	// Endpoint is the method and path of the request without the api prefix
+	// and with IDs replaced, e.g. "GET products/:id/variants.json"
+	Endpoint string
+
+	Method string
+
+	// Status is the HTTP status of the response, 0 if no response was received
+	Status int
+
+	// Latency is the time it took to receive the response
+	Latency time.Duration
+
+	// Attempt is the attempt number of the request starting at 1, the number
+	// of retries is Attempt - 1
+	Attempt int
+
+	// BucketUsed and BucketSize are read from the X-Shopify-Shop-Api-Call-Limit
+	// header, they are 0 when Shopify did not send it
+	BucketUsed int
+	BucketSize int
+
+	// ThrottleWait is the time the client waits before retrying a rate limited
+	// attempt
+	ThrottleWait time.Duration
+
+	// Err is the transport error of the attempt, if any
+	Err error
+}
+
+// Retries returns the number of retries preceding the attempt
+func (m RequestMetrics) Retries() int {
+	return m.Attempt - 1
+}
+
+// Metrics receives the API usage of every request attempt made by a Client.
+// Implementations must be safe for concurrent use.
+type Metrics interface {
+	ObserveRequest(RequestMetrics)
+}
+
+// NoopMetrics discards all metrics, it is the default of a Client
+type NoopMetrics struct{}
+
+// ObserveRequest does nothing
+func (NoopMetrics) ObserveRequest(RequestMetrics) {}
+
+// observeAttempt reports a single request attempt to the client metrics and
+// structured logger
+func (c *Client) observeAttempt(req *http.Request, attempt int, resp *http.Response, err error, latency, throttleWait time.Duration) {
+	m := RequestMetrics{
+		Method:       req.Method,
+		Latency:      latency,
+		Attempt:      attempt,
+		ThrottleWait: throttleWait,
+		Err:          err,
+	}
+	if req.URL != nil {
+		m.Shop, m.Path = c.requestTarget(req.URL)
+		m.Service, m.Endpoint = resourceEndpoint(req.Method, m.Path)
+	}
+	if resp != nil {
+		m.Status = resp.StatusCode
+		m.BucketUsed, m.BucketSize, _ = parseCallLimit(resp.Header)
+		if req.URL != nil {
+			c.observeDeprecation(req, resp)
+		}
+	}
+
+	if c.metrics != nil {
+		c.metrics.ObserveRequest(m)
+	}
+	c.logAttempt(req.Context(), m)
+}
+
+// resourceEndpoint returns the top level resource and endpoint name of a
+// request path
+func resourceEndpoint(method, path string) (service, endpoint string) {
+	path = apiPrefixRegex.ReplaceAllString(strings.TrimLeft(path, "/"), "")
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		id := strings.TrimSuffix(segment, ".json")
+		if id != "" && strings.Trim(id, "0123456789") == "" {
+			segments[i] = ":id" + strings.TrimPrefix(segment, id)
+		}
+	}
+
+	service = strings.TrimSuffix(segments[0], ".json")
+	return service, method + " " + strings.Join(segments, "/")
+}
+
+// MetricsSummary aggregates the request attempts of a shop or an endpoint
+type MetricsSummary struct {
+	Requests     int
+	Errors       int
+	Retries      int
+	Throttled    int
+	ThrottleWait time.Duration
+
+	// latency percentiles over the most recent attempts
+	LatencyP50 time.Duration
+	LatencyP95 time.Duration
+	LatencyP99 time.Duration
+
+	// BucketUsed and BucketSize are the last bucket fill reported by Shopify,
+	// MaxBucketUsed is the highest one seen
+	BucketUsed    int
+	BucketSize    int
+	MaxBucketUsed int
+}
+
+// BucketFill returns the last reported bucket fill as a ratio between 0 and 1
+func (s MetricsSummary) BucketFill() float64 {
+	if s.BucketSize == 0 {
+		return 0
+	}
+	return float64(s.BucketUsed) / float64(s.BucketSize)
+}
+
+// metricsAggregate collects the attempts of a single shop or endpoint
+type metricsAggregate struct {
+	summary   MetricsSummary
+	latencies []time.Duration
+	next      int
+}
+
+func (a *metricsAggregate) add(m RequestMetrics, sampleSize int) {
+	a.summary.Requests++
+	if m.Err != nil || m.Status == 0 || m.Status >= http.StatusBadRequest {
+		a.summary.Errors++
+	}
+	if m.Attempt > 1 {
+		a.summary.Retries++
+	}
+	if m.Status == http.StatusTooManyRequests || m.Status == 430 {
+		a.summary.Throttled++
+	}
+	a.summary.ThrottleWait += m.ThrottleWait
+	if m.BucketSize > 0 {
+		a.summary.BucketUsed = m.BucketUsed
+		a.summary.BucketSize = m.BucketSize
+		if m.BucketUsed > a.summary.MaxBucketUsed {
+			a.summary.MaxBucketUsed = m.BucketUsed
+		}
+	}
+
+	// keep the most recent latencies in a ring
+	if len(a.latencies) < sampleSize {
+		a.latencies = append(a.latencies, m.Latency)
+	} else {
+		a.latencies[a.next] = m.Latency
+		a.next = (a.next + 1) % sampleSize
+	}
+}
+
+func (a *metricsAggregate) result() MetricsSummary {
+	summary := a.summary
+	if len(a.latencies) == 0 {
+		return summary
+	}
+
+	sorted := make([]time.Duration, len(a.latencies))
+	copy(sorted, a.latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	summary.LatencyP50 = percentile(sorted, 50)
+	summary.LatencyP95 = percentile(sorted, 95)
+	summary.LatencyP99 = percentile(sorted, 99)
+	return summary
+}
+
+// percentile returns the nearest-rank percentile of sorted latencies
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// MemoryMetrics aggregates request metrics in memory per shop and per
+// endpoint. It is safe for concurrent use and can be shared across clients.
+type MemoryMetrics struct {
+	// SampleSize is the number of most recent latencies kept per shop and
+	// endpoint to compute percentiles, defaults to 1000
+	SampleSize int
+
+	mu        sync.Mutex
+	shops     map[string]*metricsAggregate
+	endpoints map[string]*metricsAggregate
+}
+
+// NewMemoryMetrics returns an empty MemoryMetrics
+func NewMemoryMetrics() *MemoryMetrics {
+	return &MemoryMetrics{
+		SampleSize: defaultMetricsSampleSize,
+	}
+}
+
+// ObserveRequest adds a request attempt to the shop and endpoint summaries
+func (m *MemoryMetrics) ObserveRequest(r RequestMetrics) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.shops == nil {
+		m.shops = map[string]*metricsAggregate{}
+		m.endpoints = map[string]*metricsAggregate{}
+	}
+	sampleSize := m.SampleSize
+	if sampleSize <= 0 {
+		sampleSize = defaultMetricsSampleSize
+	}
+
+	observe(m.shops, r.Shop, r, sampleSize)
+	observe(m.endpoints, r.Endpoint, r, sampleSize)
+}
+
+func observe(aggregates map[string]*metricsAggregate, key string, r RequestMetrics, sampleSize int) {
+	aggregate, ok := aggregates[key]
+	if !ok {
+		aggregate = &metricsAggregate{}
+		aggregates[key] = aggregate
+	}
+	aggregate.add(r, sampleSize)
+}
+
+// ShopSummaries returns the summaries keyed by shop
+func (m *MemoryMetrics) ShopSummaries() map[string]MetricsSummary {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return summarize(m.shops)
+}
+
+// EndpointSummaries returns the summaries keyed by endpoint, e.g.
+// "GET products/:id.json"
+func (m *MemoryMetrics) EndpointSummaries() map[string]MetricsSummary {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return summarize(m.endpoints)
+}
+
+// Reset removes all collected metrics
+func (m *MemoryMetrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.shops = nil
+	m.endpoints = nil
+}
+
+func summarize(aggregates map[string]*metricsAggregate) map[string]MetricsSummary {
+	summaries := make(map[string]MetricsSummary, len(aggregates))
+	for key, aggregate := range aggregates {
+		summaries[key] = aggregate.result()
+	}
+	return summaries
+}
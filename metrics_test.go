@@ -0,0 +1,243 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+// recordingMetrics records every observed request attempt
+type recordingMetrics struct {
+	mu       sync.Mutex
+	observed []RequestMetrics
+}
+
+func (r *recordingMetrics) ObserveRequest(m RequestMetrics) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.observed = append(r.observed, m)
+}
+
+func TestWithMetrics(t *testing.T) {
+	metrics := NewMemoryMetrics()
+	c := NewClient(app, "fooshop", "abcd", WithMetrics(metrics))
+	if c.metrics != metrics {
+		t.Errorf("WithMetrics client.metrics = %v, expected %v", c.metrics, metrics)
+	}
+
+	c = NewClient(app, "fooshop", "abcd")
+	if _, ok := c.metrics.(NoopMetrics); !ok {
+		t.Errorf("NewClient client.metrics = %T, expected NoopMetrics", c.metrics)
+	}
+}
+
+func TestMetricsEndpoint(t *testing.T) {
+	cases := []struct {
+		method, path              string
+		expectedService, expected string
+	}{
+		{"GET", "/admin/api/2024-01/products.json", "products", "GET products.json"},
+		{"GET", "/admin/api/2024-01/products/632910392.json", "products", "GET products/:id.json"},
+		{"PUT", "/admin/products/1/variants/2.json", "products", "PUT products/:id/variants/:id.json"},
+		{"GET", "/admin/api/unstable/shop.json", "shop", "GET shop.json"},
+		{"POST", "/api/2024-01/graphql.json", "graphql", "POST graphql.json"},
+	}
+
+	for _, c := range cases {
+		service, endpoint := resourceEndpoint(c.method, c.path)
+		if service != c.expectedService || endpoint != c.expected {
+			t.Errorf("resourceEndpoint(%s, %s) = %s, %s, expected %s, %s", c.method, c.path, service, endpoint, c.expectedService, c.expected)
+		}
+	}
+}
+
+func TestClientRecordsMetrics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	metrics := &recordingMetrics{}
+	client.metrics = metrics
+
+	attempts := 0
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products/1.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			attempts++
+			if attempts == 1 {
+				resp := httpmock.NewStringResponse(http.StatusTooManyRequests, `{"errors":"Exceeded 2 calls per second for api client."}`)
+				resp.Header.Set("Retry-After", "1.0")
+				resp.Header.Set("X-Shopify-Shop-Api-Call-Limit", "40/40")
+				return resp, nil
+			}
+			resp := httpmock.NewStringResponse(200, `{"product":{"id":1}}`)
+			resp.Header.Set("X-Shopify-Shop-Api-Call-Limit", "39/40")
+			return resp, nil
+		})
+
+	_, err := client.Product.Get(context.Background(), 1, nil)
+	if err != nil {
+		t.Fatalf("Product.Get returned error: %v", err)
+	}
+
+	if len(metrics.observed) != 2 {
+		t.Fatalf("metrics observed %d attempts, expected 2", len(metrics.observed))
+	}
+
+	throttled, ok := metrics.observed[0], metrics.observed[1]
+	cases := []struct {
+		field    string
+		expected interface{}
+		actual   interface{}
+	}{
+		{"Shop", "fooshop.myshopify.com", throttled.Shop},
+		{"Service", "products", throttled.Service},
+		{"Endpoint", "GET products/:id.json", throttled.Endpoint},
+		{"Method", "GET", throttled.Method},
+		{"Status", http.StatusTooManyRequests, throttled.Status},
+		{"Attempt", 1, throttled.Attempt},
+		{"BucketUsed", 40, throttled.BucketUsed},
+		{"BucketSize", 40, throttled.BucketSize},
+		{"ThrottleWait", time.Second, throttled.ThrottleWait},
+		{"Status", http.StatusOK, ok.Status},
+		{"Attempt", 2, ok.Attempt},
+		{"Retries", 1, ok.Retries()},
+		{"BucketUsed", 39, ok.BucketUsed},
+		{"ThrottleWait", time.Duration(0), ok.ThrottleWait},
+	}
+
+	for _, c := range cases {
+		if c.expected != c.actual {
+			t.Errorf("RequestMetrics.%v = %v, expected %v", c.field, c.actual, c.expected)
+		}
+	}
+}
+
+func TestMemoryMetrics(t *testing.T) {
+	metrics := NewMemoryMetrics()
+
+	for i := 1; i <= 100; i++ {
+		metrics.ObserveRequest(RequestMetrics{
+			Shop:       "fooshop.myshopify.com",
+			Endpoint:   "GET products.json",
+			Status:     http.StatusOK,
+			Latency:    time.Duration(i) * time.Millisecond,
+			Attempt:    1,
+			BucketUsed: i % 40,
+			BucketSize: 40,
+		})
+	}
+	metrics.ObserveRequest(RequestMetrics{
+		Shop:         "fooshop.myshopify.com",
+		Endpoint:     "GET orders.json",
+		Status:       http.StatusTooManyRequests,
+		Latency:      time.Millisecond,
+		Attempt:      2,
+		ThrottleWait: 2 * time.Second,
+		BucketUsed:   40,
+		BucketSize:   40,
+	})
+	metrics.ObserveRequest(RequestMetrics{
+		Shop:     "barshop.myshopify.com",
+		Endpoint: "GET orders.json",
+		Latency:  time.Millisecond,
+		Attempt:  1,
+		Err:      context.DeadlineExceeded,
+	})
+
+	products := metrics.EndpointSummaries()["GET products.json"]
+	if products.Requests != 100 || products.Errors != 0 {
+		t.Errorf("products summary = %+v, expected 100 requests without errors", products)
+	}
+	if products.LatencyP50 != 50*time.Millisecond || products.LatencyP95 != 95*time.Millisecond || products.LatencyP99 != 99*time.Millisecond {
+		t.Errorf("products latency percentiles = %v/%v/%v, expected 50ms/95ms/99ms", products.LatencyP50, products.LatencyP95, products.LatencyP99)
+	}
+
+	orders := metrics.EndpointSummaries()["GET orders.json"]
+	if orders.Requests != 2 || orders.Errors != 2 || orders.Throttled != 1 || orders.Retries != 1 || orders.ThrottleWait != 2*time.Second {
+		t.Errorf("orders summary = %+v, expected 2 failed requests with 1 throttle", orders)
+	}
+
+	shops := metrics.ShopSummaries()
+	foo := shops["fooshop.myshopify.com"]
+	if foo.Requests != 101 || foo.BucketUsed != 40 || foo.MaxBucketUsed != 40 || foo.BucketFill() != 1 {
+		t.Errorf("fooshop summary = %+v, expected 101 requests with a full bucket", foo)
+	}
+	if shops["barshop.myshopify.com"].Requests != 1 {
+		t.Errorf("barshop summary = %+v, expected 1 request", shops["barshop.myshopify.com"])
+	}
+
+	metrics.Reset()
+	if len(metrics.ShopSummaries()) != 0 {
+		t.Errorf("MemoryMetrics.Reset kept %d shop summaries", len(metrics.ShopSummaries()))
+	}
+}
+
+func TestMemoryMetricsSampleSize(t *testing.T) {
+	metrics := NewMemoryMetrics()
+	metrics.SampleSize = 10
+
+	for i := 1; i <= 20; i++ {
+		metrics.ObserveRequest(RequestMetrics{Endpoint: "GET shop.json", Status: 200, Latency: time.Duration(i) * time.Millisecond})
+	}
+
+	summary := metrics.EndpointSummaries()["GET shop.json"]
+	if summary.Requests != 20 {
+		t.Errorf("summary.Requests = %d, expected 20", summary.Requests)
+	}
+	// only the 10 most recent latencies, 11ms to 20ms, are kept
+	if summary.LatencyP50 != 15*time.Millisecond {
+		t.Errorf("summary.LatencyP50 = %v, expected 15ms", summary.LatencyP50)
+	}
+}
+
+func TestClientRecordsConcurrentMetrics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	metrics := &recordingMetrics{}
+	client.metrics = metrics
+
+	var mu sync.Mutex
+	failed := make(map[string]bool)
+	httpmock.RegisterRegexpResponder("GET", regexp.MustCompile(`/products/\d+\.json$`),
+		func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if !failed[req.URL.Path] {
+				// the first attempt of each call is retried
+				failed[req.URL.Path] = true
+				return httpmock.NewStringResponse(http.StatusServiceUnavailable, ""), nil
+			}
+			resp := httpmock.NewStringResponse(200, `{"product":{"id":1}}`)
+			resp.Header.Set("X-Shopify-Shop-Api-Call-Limit", "1/40")
+			return resp, nil
+		})
+
+	var wg sync.WaitGroup
+	for id := int64(1); id <= 10; id++ {
+		wg.Add(1)
+		go func(id int64) {
+			defer wg.Done()
+			if _, err := client.Product.Get(context.Background(), id, nil); err != nil {
+				t.Errorf("Product.Get(%d) returned error: %v", id, err)
+			}
+		}(id)
+	}
+	wg.Wait()
+
+	attempts := make(map[int]int)
+	for _, m := range metrics.observed {
+		attempts[m.Attempt]++
+	}
+	if attempts[1] != 10 || attempts[2] != 10 || len(attempts) != 2 {
+		t.Errorf("metrics observed attempts %v, expected 10 first and 10 second attempts", attempts)
+	}
+	if client.RateLimits.RequestCount != 1 {
+		t.Errorf("client.RateLimits.RequestCount = %d, expected 1", client.RateLimits.RequestCount)
+	}
+}
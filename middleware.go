@@ -0,0 +1,106 @@
+package goshopify
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+const requestIDHeader = "X-Request-Id"
+
+type requestIDKey struct{}
+
+// RequestInfo describes a single attempt of an API request
+type RequestInfo struct {
+	// Shop is the host of the shop the request is sent to, e.g.
+	// "fooshop.myshopify.com"
+	Shop string
+
+	// Method is the HTTP method of the request
+	Method string
+
+	// Path is the resolved resource path including the api prefix, e.g.
+	// "admin/api/2024-01/products.json"
+	Path string
+
+	// Attempt is the attempt number of the request, starting at 1 and
+	// increasing with every retry
+	Attempt int
+}
+
+// RequestHandler sends a request to Shopify and returns its response
+type RequestHandler func(req *http.Request, info RequestInfo) (*http.Response, error)
+
+// Middleware wraps a RequestHandler to intercept requests and responses.
+// Middlewares are called on every attempt, including retries, and must
+// call next to send the request on.
+type Middleware func(next RequestHandler) RequestHandler
+
+// WithRequestID returns a context carrying a request ID which is sent as the
+// X-Request-Id header by RequestIDMiddleware
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID set with WithRequestID
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDKey{}).(string)
+	return requestID, ok && requestID != ""
+}
+
+// RequestIDMiddleware sends the request ID set with WithRequestID on the
+// request context as the X-Request-Id header
+func RequestIDMiddleware() Middleware {
+	return func(next RequestHandler) RequestHandler {
+		return func(req *http.Request, info RequestInfo) (*http.Response, error) {
+			if requestID, ok := RequestIDFromContext(req.Context()); ok {
+				req.Header.Set(requestIDHeader, requestID)
+			}
+			return next(req, info)
+		}
+	}
+}
+
+// UserAgentMiddleware appends the given suffix to the User-Agent header,
+// e.g. "goshopify/1.0.0 my-app/2.3"
+func UserAgentMiddleware(suffix string) Middleware {
+	return func(next RequestHandler) RequestHandler {
+		return func(req *http.Request, info RequestInfo) (*http.Response, error) {
+			userAgent := req.Header.Get("User-Agent")
+			if userAgent == "" {
+				userAgent = UserAgent
+			}
+			// the same request is sent again on retries
+			if suffix != "" && !strings.HasSuffix(userAgent, " "+suffix) {
+				req.Header.Set("User-Agent", userAgent+" "+suffix)
+			}
+			return next(req, info)
+		}
+	}
+}
+
+// send sends a single attempt of the request through the middleware chain
+func (c *Client) send(req *http.Request, attempt int) (*http.Response, error) {
+	var handler RequestHandler = func(req *http.Request, _ RequestInfo) (*http.Response, error) {
+		return c.httpClient(req).Do(req)
+	}
+	if c.cache != nil {
+		// the cache is the innermost handler whatever the order of the options
+		handler = c.cache.Middleware()(handler)
+	}
+
+	// wrap in reverse order so the first middleware is the outermost one
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		handler = c.middlewares[i](handler)
+	}
+
+	info := RequestInfo{
+		Method:  req.Method,
+		Attempt: attempt,
+	}
+	if req.URL != nil {
+		info.Shop, info.Path = c.requestTarget(req.URL)
+	}
+
+	return handler(req, info)
+}
@@ -0,0 +1,247 @@
+package goshopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestWithMiddleware(t *testing.T) {
+	first := func(next RequestHandler) RequestHandler { return next }
+	second := func(next RequestHandler) RequestHandler { return next }
+
+	c := NewClient(app, "fooshop", "abcd", WithMiddleware(first), WithMiddleware(second))
+	if len(c.middlewares) != 2 {
+		t.Errorf("WithMiddleware client.middlewares has %d middlewares, expected %d", len(c.middlewares), 2)
+	}
+}
+
+func TestMiddlewareOrderAndInfo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var calls []string
+	var infos []RequestInfo
+	record := func(name string) Middleware {
+		return func(next RequestHandler) RequestHandler {
+			return func(req *http.Request, info RequestInfo) (*http.Response, error) {
+				calls = append(calls, name+":before")
+				infos = append(infos, info)
+				resp, err := next(req, info)
+				calls = append(calls, name+":after")
+				return resp, err
+			}
+		}
+	}
+	client.middlewares = []Middleware{record("outer"), record("inner")}
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shop.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("shop.json")))
+
+	_, err := client.Shop.Get(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Shop.Get returned error: %v", err)
+	}
+
+	expectedCalls := []string{"outer:before", "inner:before", "inner:after", "outer:after"}
+	if !reflect.DeepEqual(calls, expectedCalls) {
+		t.Errorf("middlewares called %v, expected %v", calls, expectedCalls)
+	}
+
+	expectedInfo := RequestInfo{
+		Shop:    "fooshop.myshopify.com",
+		Method:  "GET",
+		Path:    fmt.Sprintf("%s/shop.json", client.pathPrefix),
+		Attempt: 1,
+	}
+	if infos[0] != expectedInfo {
+		t.Errorf("middleware RequestInfo = %+v, expected %+v", infos[0], expectedInfo)
+	}
+}
+
+func TestMiddlewareInfoBaseURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client = NewClient(app, "fooshop", "abcd", WithVersion(testApiVersion), WithBaseURL("http://proxy.local/shopify/fooshop"))
+	httpmock.ActivateNonDefault(client.Client)
+
+	var info RequestInfo
+	client.middlewares = []Middleware{func(next RequestHandler) RequestHandler {
+		return func(req *http.Request, i RequestInfo) (*http.Response, error) {
+			info = i
+			return next(req, i)
+		}
+	}}
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("http://proxy.local/shopify/fooshop/%s/shop.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("shop.json")))
+
+	_, err := client.Shop.Get(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Shop.Get returned error: %v", err)
+	}
+
+	expectedInfo := RequestInfo{
+		Shop:    "fooshop.myshopify.com",
+		Method:  "GET",
+		Path:    fmt.Sprintf("%s/shop.json", client.pathPrefix),
+		Attempt: 1,
+	}
+	if info != expectedInfo {
+		t.Errorf("middleware RequestInfo = %+v, expected %+v", info, expectedInfo)
+	}
+}
+
+func TestMiddlewareAttempts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var attempts []int
+	client.middlewares = []Middleware{
+		func(next RequestHandler) RequestHandler {
+			return func(req *http.Request, info RequestInfo) (*http.Response, error) {
+				attempts = append(attempts, info.Attempt)
+				return next(req, info)
+			}
+		},
+	}
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shop.json", client.pathPrefix),
+		httpmock.NewStringResponder(http.StatusServiceUnavailable, ""))
+
+	_, _ = client.Shop.Get(context.Background(), nil)
+
+	expected := []int{1, 2, 3}
+	if !reflect.DeepEqual(attempts, expected) {
+		t.Errorf("middleware attempts = %v, expected %v", attempts, expected)
+	}
+}
+
+func TestMiddlewareShortCircuit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	faultErr := errors.New("injected fault")
+	client.middlewares = []Middleware{
+		func(next RequestHandler) RequestHandler {
+			return func(req *http.Request, info RequestInfo) (*http.Response, error) {
+				return nil, faultErr
+			}
+		},
+	}
+
+	_, err := client.Shop.Get(context.Background(), nil)
+	if !errors.Is(err, faultErr) {
+		t.Errorf("Shop.Get returned %v, expected %v", err, faultErr)
+	}
+
+	if calls := httpmock.GetTotalCallCount(); calls != 0 {
+		t.Errorf("%d requests were sent despite the middleware short circuiting", calls)
+	}
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.middlewares = []Middleware{RequestIDMiddleware()}
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shop.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if requestID := req.Header.Get("X-Request-Id"); requestID != "req-123" {
+				t.Errorf("X-Request-Id header = %v, expected %v", requestID, "req-123")
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("shop.json")), nil
+		})
+
+	_, err := client.Shop.Get(WithRequestID(context.Background(), "req-123"), nil)
+	if err != nil {
+		t.Errorf("Shop.Get returned error: %v", err)
+	}
+}
+
+func TestUserAgentMiddleware(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.middlewares = []Middleware{UserAgentMiddleware("my-app/2.3")}
+
+	attempts := 0
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shop.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			expected := UserAgent + " my-app/2.3"
+			if userAgent := req.Header.Get("User-Agent"); userAgent != expected {
+				t.Errorf("User-Agent header = %v, expected %v", userAgent, expected)
+			}
+			attempts++
+			if attempts == 1 {
+				return httpmock.NewStringResponse(http.StatusServiceUnavailable, ""), nil
+			}
+			return httpmock.NewBytesResponse(200, loadFixture("shop.json")), nil
+		})
+
+	_, err := client.Shop.Get(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Shop.Get returned error: %v", err)
+	}
+}
+
+func TestMiddlewareConcurrentAttempts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	attempts := make(map[string][]int)
+	client.middlewares = []Middleware{func(next RequestHandler) RequestHandler {
+		return func(req *http.Request, info RequestInfo) (*http.Response, error) {
+			mu.Lock()
+			attempts[info.Path] = append(attempts[info.Path], info.Attempt)
+			mu.Unlock()
+			return next(req, info)
+		}
+	}}
+
+	var failures int32
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if atomic.AddInt32(&failures, 1) < maxRetries {
+				return httpmock.NewStringResponse(http.StatusServiceUnavailable, ""), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"products":[]}`), nil
+		})
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shop.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("shop.json")))
+
+	// the attempts of concurrent calls are numbered separately
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		if _, err := client.Product.List(context.Background(), nil); err != nil {
+			t.Errorf("Product.List returned error: %v", err)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		if _, err := client.Shop.Get(context.Background(), nil); err != nil {
+			t.Errorf("Shop.Get returned error: %v", err)
+		}
+	}()
+	wg.Wait()
+
+	expected := map[string][]int{
+		fmt.Sprintf("%s/products.json", client.pathPrefix): {1, 2, 3},
+		fmt.Sprintf("%s/shop.json", client.pathPrefix):     {1},
+	}
+	if !reflect.DeepEqual(attempts, expected) {
+		t.Errorf("middleware attempts = %v, expected %v", attempts, expected)
+	}
+}
@@ -0,0 +1,173 @@
+package goshopify
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+const multipassSecret = "abcdef0123456789"
+
+// decryptMultipassToken is a reference implementation of the decryption
+// Shopify performs on a Multipass token.
+func decryptMultipassToken(t *testing.T, secret, token string) map[string]interface{} {
+	keyMaterial := sha256.Sum256([]byte(secret))
+	encryptionKey, signatureKey := keyMaterial[:16], keyMaterial[16:]
+
+	raw, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		t.Fatalf("token is not URL-safe base64: %v", err)
+	}
+	if len(raw) < aes.BlockSize+sha256.Size {
+		t.Fatalf("token too short: %d bytes", len(raw))
+	}
+
+	cipherText, signature := raw[:len(raw)-sha256.Size], raw[len(raw)-sha256.Size:]
+	mac := hmac.New(sha256.New, signatureKey)
+	mac.Write(cipherText)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		t.Fatal("token signature does not match")
+	}
+
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iv, data := cipherText[:aes.BlockSize], cipherText[aes.BlockSize:]
+	if len(data)%aes.BlockSize != 0 {
+		t.Fatalf("cipher text is not a multiple of the block size")
+	}
+	plainText := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plainText, data)
+	padding := int(plainText[len(plainText)-1])
+	plainText = plainText[:len(plainText)-padding]
+
+	customer := map[string]interface{}{}
+	if err := json.Unmarshal(plainText, &customer); err != nil {
+		t.Fatalf("decrypted payload is not JSON: %v", err)
+	}
+	return customer
+}
+
+func TestMultipassToken(t *testing.T) {
+	createdAt := time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC)
+	customer := MultipassCustomer{
+		Email:     "bob@shopify.com",
+		CreatedAt: &createdAt,
+		FirstName: "Bob",
+		LastName:  "Bobsen",
+		ReturnTo:  "https://fooshop.com/cart",
+		Addresses: []*CustomerAddress{{Address1: "123 Oak St", City: "Ottawa"}},
+	}
+
+	token, err := NewMultipass(multipassSecret).Token(customer)
+	if err != nil {
+		t.Fatalf("Multipass.Token returned error: %v", err)
+	}
+
+	decoded := decryptMultipassToken(t, multipassSecret, token)
+	cases := []struct {
+		field    string
+		expected interface{}
+	}{
+		{"email", "bob@shopify.com"},
+		{"created_at", "2024-03-04T10:00:00Z"},
+		{"first_name", "Bob"},
+		{"last_name", "Bobsen"},
+		{"return_to", "https://fooshop.com/cart"},
+	}
+	for _, c := range cases {
+		if decoded[c.field] != c.expected {
+			t.Errorf("Multipass.Token %v returned %v, expected %v", c.field, decoded[c.field], c.expected)
+		}
+	}
+
+	addresses, ok := decoded["addresses"].([]interface{})
+	if !ok || len(addresses) != 1 {
+		t.Fatalf("Multipass.Token addresses returned %v, expected 1 address", decoded["addresses"])
+	}
+}
+
+func TestMultipassTokenDefaultsCreatedAt(t *testing.T) {
+	token, err := NewMultipass(multipassSecret).Token(MultipassCustomer{Email: "bob@shopify.com"})
+	if err != nil {
+		t.Fatalf("Multipass.Token returned error: %v", err)
+	}
+
+	decoded := decryptMultipassToken(t, multipassSecret, token)
+	createdAt, err := time.Parse(time.RFC3339, decoded["created_at"].(string))
+	if err != nil {
+		t.Fatalf("Multipass.Token created_at is not RFC3339: %v", err)
+	}
+	if time.Since(createdAt) > time.Minute {
+		t.Errorf("Multipass.Token created_at returned %v, expected current time", createdAt)
+	}
+}
+
+func TestMultipassTokenRandomIV(t *testing.T) {
+	m := NewMultipass(multipassSecret)
+	customer := MultipassCustomer{Email: "bob@shopify.com"}
+
+	first, _ := m.Token(customer)
+	second, _ := m.Token(customer)
+	if first == second {
+		t.Error("Multipass.Token returned the same token twice, expected a random IV")
+	}
+
+	m.randOverride = bytes.NewReader(make([]byte, aes.BlockSize))
+	token, err := m.Token(customer)
+	if err != nil {
+		t.Fatalf("Multipass.Token returned error: %v", err)
+	}
+	raw, _ := base64.URLEncoding.DecodeString(token)
+	if !bytes.Equal(raw[:aes.BlockSize], make([]byte, aes.BlockSize)) {
+		t.Errorf("Multipass.Token IV returned %x, expected the random source output", raw[:aes.BlockSize])
+	}
+}
+
+func TestMultipassTokenWrongSecret(t *testing.T) {
+	token, err := NewMultipass(multipassSecret).Token(MultipassCustomer{Email: "bob@shopify.com"})
+	if err != nil {
+		t.Fatalf("Multipass.Token returned error: %v", err)
+	}
+
+	keyMaterial := sha256.Sum256([]byte("wrong secret"))
+	raw, _ := base64.URLEncoding.DecodeString(token)
+	cipherText, signature := raw[:len(raw)-sha256.Size], raw[len(raw)-sha256.Size:]
+	mac := hmac.New(sha256.New, keyMaterial[16:])
+	mac.Write(cipherText)
+	if hmac.Equal(signature, mac.Sum(nil)) {
+		t.Error("Multipass.Token signature verified with the wrong secret")
+	}
+}
+
+func TestMultipassTokenMissingEmail(t *testing.T) {
+	_, err := NewMultipass(multipassSecret).Token(MultipassCustomer{FirstName: "Bob"})
+	if err == nil {
+		t.Error("Multipass.Token expected error for missing email")
+	}
+}
+
+func TestMultipassURL(t *testing.T) {
+	u, err := NewMultipass(multipassSecret).URL("fooshop", MultipassCustomer{Email: "bob@shopify.com"})
+	if err != nil {
+		t.Fatalf("Multipass.URL returned error: %v", err)
+	}
+
+	prefix := "https://fooshop.myshopify.com/account/login/multipass/"
+	if !strings.HasPrefix(u, prefix) {
+		t.Fatalf("Multipass.URL returned %v, expected prefix %v", u, prefix)
+	}
+
+	decoded := decryptMultipassToken(t, multipassSecret, strings.TrimPrefix(u, prefix))
+	if decoded["email"] != "bob@shopify.com" {
+		t.Errorf("Multipass.URL email returned %v, expected %v", decoded["email"], "bob@shopify.com")
+	}
+}
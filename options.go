@@ -3,6 +3,8 @@ package goshopify
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 // Option is used to configure client with options
@@ -20,6 +22,37 @@ func WithVersion(apiVersion string) Option {
 	}
 }
 
+// WithBaseURL optionally sends the requests to another host than the shop's
+// myshopify domain, e.g. a proxy or a fake server in tests. The scheme, host
+// and path of the url replace "https://<shop>.myshopify.com/", the api prefix
+// is appended to the path, e.g. "http://proxy.local/shopify/fooshop" gives
+// "http://proxy.local/shopify/fooshop/admin/api/2024-01/products.json".
+// The base url must be a valid absolute http or https url, otherwise
+// NewClientE returns an error and the client of NewClient logs it and
+// refuses every request.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		u, err := url.Parse(baseURL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			if c.optionErr == nil {
+				c.optionErr = fmt.Errorf("invalid base url %q, expected an absolute url", baseURL)
+			}
+			return
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			if c.optionErr == nil {
+				c.optionErr = fmt.Errorf("invalid base url %q, expected an http or https url", baseURL)
+			}
+			return
+		}
+		u.RawQuery, u.Fragment = "", ""
+		if !strings.HasSuffix(u.Path, "/") {
+			u.Path += "/"
+		}
+		c.baseURL = u
+	}
+}
+
 func WithRetry(retries int) Option {
 	return func(c *Client) {
 		c.retries = retries
@@ -32,9 +65,118 @@ func WithLogger(logger LeveledLoggerInterface) Option {
 	}
 }
 
-// WithHTTPClient is used to set a custom http client
+// WithHTTPClient is used to set a custom http client. A client without a
+// CheckRedirect policy is copied to follow the redirects like the default
+// one, which keeps the access token from other hosts.
 func WithHTTPClient(client *http.Client) Option {
 	return func(c *Client) {
-		c.Client = client
+		// the option may be applied concurrently, e.g. by FanOut
+		hc := client
+		if hc != nil && hc.CheckRedirect == nil {
+			withPolicy := *hc
+			withPolicy.CheckRedirect = checkRedirect
+			hc = &withPolicy
+		}
+		c.Client = hc
+	}
+}
+
+// WithMiddleware appends middlewares to the chain of request interceptors.
+// The first middleware given is the outermost one.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(c *Client) {
+		c.middlewares = append(c.middlewares, middlewares...)
+	}
+}
+
+// WithMetrics sets the Metrics receiving the API usage of every request attempt
+func WithMetrics(metrics Metrics) Option {
+	return func(c *Client) {
+		c.metrics = metrics
+	}
+}
+
+// WithRedactor masks personal data and secrets in logged request URLs and
+// bodies, see NewRedactor
+func WithRedactor(redactor *Redactor) Option {
+	return func(c *Client) {
+		c.redactor = redactor
+	}
+}
+
+// WithLogBodyLimit sets the max number of request and response body bytes
+// written to debug logs, longer bodies are truncated
+func WithLogBodyLimit(limit int) Option {
+	return func(c *Client) {
+		c.logBodyLimit = limit
+	}
+}
+
+// WithCache serves GET requests from the given cache, see Cache. The cache
+// can be shared by several clients. Fresh entries are served before the
+// middlewares, metrics and rate limits, as no request is sent, and the
+// revalidations are sent through the middlewares whatever the order of the
+// options.
+func WithCache(cache *Cache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithDeprecationHandler calls handler after every call Shopify reports as
+// deprecated, with the updated Deprecation of the endpoint. The whole report
+// is returned by Client.Deprecations.
+func WithDeprecationHandler(handler DeprecationHandler) Option {
+	return func(c *Client) {
+		c.deprecationHandler = handler
+	}
+}
+
+// WithWaitForCompletion makes the client wait for the long-running work
+// Shopify accepts with a 202 response, such as creating a theme from a zip.
+// The Location of the response is polled, honoring Retry-After, until the
+// resource is ready and decoded into the resource of the call. Without it
+// the call succeeds with the body of the 202 response, if any.
+func WithWaitForCompletion() Option {
+	return func(c *Client) {
+		c.waitForCompletion = true
+	}
+}
+
+// WithPendingErrors makes the calls accepted with a 202 response without a
+// body return a PendingError, whose Location can be polled later with
+// Client.WaitForCompletion. It has no effect with WithWaitForCompletion.
+func WithPendingErrors() Option {
+	return func(c *Client) {
+		c.pendingErrors = true
+	}
+}
+
+// WithReadOnly makes the client refuse the POST, PUT, PATCH and DELETE
+// requests and the GraphQL mutations with a ReadOnlyError before they are
+// sent, e.g. for jobs using production tokens which must never write. The
+// GraphQL requests to graphql.json with a mutation or subscription, or which
+// can't be parsed, are refused.
+func WithReadOnly() Option {
+	return func(c *Client) {
+		c.readOnly = true
+	}
+}
+
+// WithDryRun makes the client log the method, path and body of the writes
+// instead of sending them. The payload is echoed as the response, so the
+// services return the resource they were given.
+func WithDryRun() Option {
+	return func(c *Client) {
+		c.dryRun = true
+	}
+}
+
+// WithCircuitBreaker stops sending requests to the shops the given breaker
+// considers unavailable, see CircuitBreaker. The breaker can be shared by
+// several clients, which then share the state of the shops.
+func WithCircuitBreaker(breaker *CircuitBreaker) Option {
+	return func(c *Client) {
+		c.middlewares = append(c.middlewares, breaker.Middleware())
 	}
 }
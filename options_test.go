@@ -1,10 +1,15 @@
 package goshopify
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/jarcoal/httpmock"
 )
 
 func TestWithVersion(t *testing.T) {
@@ -72,3 +77,41 @@ func TestWithHTTPClient(t *testing.T) {
 		t.Errorf("WithVersion client.Client = %s, expected %s", c.Client.Timeout, expected)
 	}
 }
+
+func TestWithBaseURL(t *testing.T) {
+	cases := []struct {
+		baseURL  string
+		expected string
+	}{
+		{"http://127.0.0.1:8080", "http://127.0.0.1:8080/"},
+		{"https://proxy.example.com/shopify", "https://proxy.example.com/shopify/"},
+		{"not a url", "https://fooshop.myshopify.com"},
+		{"/relative", "https://fooshop.myshopify.com"},
+	}
+
+	for _, c := range cases {
+		client := NewClient(app, "fooshop", "abcd", WithBaseURL(c.baseURL))
+		if client.baseURL.String() != c.expected {
+			t.Errorf("WithBaseURL(%q) client.baseURL = %s, expected %s", c.baseURL, client.baseURL, c.expected)
+		}
+	}
+}
+
+func TestWithBaseURLInvalidRefusesRequests(t *testing.T) {
+	out := &bytes.Buffer{}
+	c := NewClient(app, "fooshop", "abcd", WithLogger(&LeveledLogger{Level: LevelError, stderrOverride: out}),
+		WithBaseURL("ftp://proxy.local"))
+	if !strings.Contains(out.String(), `invalid base url "ftp://proxy.local"`) {
+		t.Errorf("NewClient logged %q, expected the invalid base url", out.String())
+	}
+
+	httpmock.ActivateNonDefault(c.Client)
+	defer httpmock.DeactivateAndReset()
+	_, err := c.Shop.Get(context.Background(), nil)
+	if err == nil || !strings.Contains(err.Error(), "invalid base url") {
+		t.Errorf("Shop.Get returned %v, expected the invalid base url error", err)
+	}
+	if calls := httpmock.GetTotalCallCount(); calls != 0 {
+		t.Errorf("expected no call, got %d", calls)
+	}
+}
@@ -19,10 +19,13 @@ const ordersResourceName = "orders"
 type OrderService interface {
 	List(context.Context, interface{}) ([]Order, error)
 	ListWithPagination(context.Context, interface{}) ([]Order, *Pagination, error)
+	StreamOrders(context.Context, interface{}, func(Order) error) error
 	Count(context.Context, interface{}) (int, error)
 	Get(context.Context, int64, interface{}) (*Order, error)
+	GetMany(context.Context, []int64) (*GetManyResult[Order], error)
 	Create(context.Context, Order) (*Order, error)
 	Update(context.Context, Order) (*Order, error)
+	Patch(context.Context, int64, *Patch[Order]) (*Order, error)
 	Cancel(context.Context, int64, interface{}) (*Order, error)
 	Close(context.Context, int64) (*Order, error)
 	Open(context.Context, int64) (*Order, error)
@@ -407,6 +410,16 @@ func (s *OrderServiceOp) ListWithPagination(ctx context.Context, options interfa
 	return resource.Orders, pagination, nil
 }
 
+// StreamOrders lists orders across all pages and calls fn with each order
+// as it is decoded, without holding a whole page in memory.
+// It stops at the first error returned by fn and returns that error.
+// The time spent in fn counts toward the http client Timeout, see
+// StreamProducts.
+func (s *OrderServiceOp) StreamOrders(ctx context.Context, options interface{}, fn func(Order) error) error {
+	path := fmt.Sprintf("%s.json", ordersBasePath)
+	return streamList(ctx, s.client, path, "orders", options, fn)
+}
+
 // Count orders
 func (s *OrderServiceOp) Count(ctx context.Context, options interface{}) (int, error) {
 	path := fmt.Sprintf("%s/count.json", ordersBasePath)
@@ -421,6 +434,14 @@ func (s *OrderServiceOp) Get(ctx context.Context, orderID int64, options interfa
 	return resource.Order, err
 }
 
+// GetMany gets orders by id, of any status, in chunks of 250 ids
+func (s *OrderServiceOp) GetMany(ctx context.Context, ids []int64) (*GetManyResult[Order], error) {
+	path := fmt.Sprintf("%s.json", ordersBasePath)
+	return getMany(ctx, s.client, path, "orders", ids, getManyChunkSize, "any", func(resource Order) int64 {
+		return resource.ID
+	})
+}
+
 // Create order
 func (s *OrderServiceOp) Create(ctx context.Context, order Order) (*Order, error) {
 	path := fmt.Sprintf("%s.json", ordersBasePath)
@@ -439,6 +460,19 @@ func (s *OrderServiceOp) Update(ctx context.Context, order Order) (*Order, error
 	return resource.Order, err
 }
 
+// Patch updates only the fields set in the patch of an existing order
+func (s *OrderServiceOp) Patch(ctx context.Context, orderID int64, patch *Patch[Order]) (*Order, error) {
+	body, err := patch.body(orderID)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("%s/%d.json", ordersBasePath, orderID)
+	wrappedData := map[string]interface{}{"order": body}
+	resource := new(OrderResource)
+	err = s.client.Put(ctx, path, wrappedData, resource)
+	return resource.Order, err
+}
+
 // Cancel order
 func (s *OrderServiceOp) Cancel(ctx context.Context, orderID int64, options interface{}) (*Order, error) {
 	path := fmt.Sprintf("%s/%d/cancel.json", ordersBasePath, orderID)
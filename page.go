@@ -19,6 +19,7 @@ type PageService interface {
 	Get(context.Context, int64, interface{}) (*Page, error)
 	Create(context.Context, Page) (*Page, error)
 	Update(context.Context, Page) (*Page, error)
+	Patch(context.Context, int64, *Patch[Page]) (*Page, error)
 	Delete(context.Context, int64) error
 
 	// MetafieldsService used for Pages resource to communicate with Metafields
@@ -105,6 +106,19 @@ func (s *PageServiceOp) Update(ctx context.Context, page Page) (*Page, error) {
 	return resource.Page, err
 }
 
+// Patch updates only the fields set in the patch of an existing page
+func (s *PageServiceOp) Patch(ctx context.Context, pageID int64, patch *Patch[Page]) (*Page, error) {
+	body, err := patch.body(pageID)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("%s/%d.json", pagesBasePath, pageID)
+	wrappedData := map[string]interface{}{"page": body}
+	resource := new(PageResource)
+	err = s.client.Put(ctx, path, wrappedData, resource)
+	return resource.Page, err
+}
+
 // Delete an existing page.
 func (s *PageServiceOp) Delete(ctx context.Context, pageID int64) error {
 	return s.client.Delete(ctx, fmt.Sprintf("%s/%d.json", pagesBasePath, pageID))
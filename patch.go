@@ -0,0 +1,198 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Patch is a partial update of a resource sending only the fields which are
+// set, including false, 0, "" and null values which are omitted by the
+// Update methods. The fields are named by their json key and checked against
+// the resource type.
+//
+//	patch := goshopify.NewPatch[goshopify.Variant]().
+//		Set("taxable", false).
+//		Set("inventory_quantity", 0).
+//		Clear("barcode")
+//	variant, err := client.Variant.Patch(ctx, variantID, patch)
+type Patch[T any] struct {
+	fields map[string]interface{}
+	err    error
+}
+
+// NewPatch returns an empty patch of a resource type
+func NewPatch[T any]() *Patch[T] {
+	return &Patch[T]{fields: make(map[string]interface{})}
+}
+
+// Set sets a field to a value, which must have the type of the field or of
+// the value it points to
+func (p *Patch[T]) Set(field string, value interface{}) *Patch[T] {
+	f, ok := p.field(field)
+	if !ok {
+		return p
+	}
+	if value == nil {
+		return p.Clear(field)
+	}
+	if !assignableTo(reflect.TypeOf(value), f.Type) {
+		p.fail(fmt.Errorf("field %q is %s, got %T", field, f.Type, value))
+		return p
+	}
+	p.fields[field] = value
+	return p
+}
+
+// SetFrom sets fields to their value in a resource, even the zero ones
+func (p *Patch[T]) SetFrom(resource T, fields ...string) *Patch[T] {
+	v := reflect.ValueOf(resource)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			p.fail(errors.New("nil resource"))
+			return p
+		}
+		v = v.Elem()
+	}
+	for _, field := range fields {
+		f, ok := p.field(field)
+		if !ok {
+			continue
+		}
+		value := v.FieldByIndex(f.Index)
+		if value.Kind() == reflect.Pointer && value.IsNil() {
+			p.Clear(field)
+			continue
+		}
+		p.fields[field] = value.Interface()
+	}
+	return p
+}
+
+// Clear sets a field to null
+func (p *Patch[T]) Clear(field string) *Patch[T] {
+	if _, ok := p.field(field); ok {
+		p.fields[field] = nil
+	}
+	return p
+}
+
+// Fields returns the sorted names of the fields set
+func (p *Patch[T]) Fields() []string {
+	fields := make([]string, 0, len(p.fields))
+	for field := range p.fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// Err returns the errors of the fields which could not be set
+func (p *Patch[T]) Err() error {
+	return p.err
+}
+
+// MarshalJSON implements json.Marshaler
+func (p *Patch[T]) MarshalJSON() ([]byte, error) {
+	if p.err != nil {
+		return nil, p.Err()
+	}
+	return json.Marshal(p.fields)
+}
+
+// body returns the fields of the patch with the id of the resource, or an
+// error if the patch is invalid or empty
+func (p *Patch[T]) body(id int64) (map[string]interface{}, error) {
+	if p == nil || len(p.fields) == 0 && p.err == nil {
+		return nil, fmt.Errorf("patch %s: no fields set", resourceTypeName[T]())
+	}
+	if p.err != nil {
+		return nil, p.err
+	}
+	body := make(map[string]interface{}, len(p.fields)+1)
+	for field, value := range p.fields {
+		body[field] = value
+	}
+	body["id"] = id
+	return body, nil
+}
+
+func (p *Patch[T]) field(name string) (reflect.StructField, bool) {
+	if p.fields == nil {
+		p.fields = make(map[string]interface{})
+	}
+	f, ok := jsonFields(reflect.TypeOf((*T)(nil)).Elem())[name]
+	if !ok {
+		p.fail(fmt.Errorf("unknown field %q", name))
+	}
+	return f, ok
+}
+
+func (p *Patch[T]) fail(err error) {
+	p.err = errors.Join(p.err, fmt.Errorf("patch %s: %w", resourceTypeName[T](), err))
+}
+
+// resourceTypeName returns the name of a resource type, e.g. "Product"
+func resourceTypeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().Name()
+}
+
+// jsonFields returns the fields of a struct type by json key, including the
+// fields of embedded structs
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	fields := make(map[string]reflect.StructField)
+	if t.Kind() != reflect.Struct {
+		return fields
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			for key, embedded := range jsonFields(f.Type) {
+				if _, ok := fields[key]; !ok {
+					embedded.Index = append([]int{i}, embedded.Index...)
+					fields[key] = embedded
+				}
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f
+	}
+	return fields
+}
+
+// assignableTo reports whether a value of type v can be set to a field of
+// type f, or to the value it points to
+func assignableTo(v, f reflect.Type) bool {
+	if v.AssignableTo(f) {
+		return true
+	}
+	if f.Kind() == reflect.Pointer && v.AssignableTo(f.Elem()) {
+		return true
+	}
+	for f.Kind() == reflect.Pointer {
+		f = f.Elem()
+	}
+	return isNumber(v.Kind()) && isNumber(f.Kind())
+}
+
+func isNumber(k reflect.Kind) bool {
+	return reflect.Int <= k && k <= reflect.Float64
+}
@@ -0,0 +1,122 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/shopspring/decimal"
+)
+
+// registerPatch registers a PUT responder checking the body of the request
+func registerPatch(t *testing.T, path, expectedBody, response string) {
+	httpmock.RegisterResponder("PUT", fmt.Sprintf("https://fooshop.myshopify.com/%s/%s", client.pathPrefix, path),
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			if string(body) != expectedBody {
+				t.Errorf("PUT %s body = %s, expected %s", path, body, expectedBody)
+			}
+			return httpmock.NewStringResponse(200, response), nil
+		})
+}
+
+func TestVariantPatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	registerPatch(t, "variants/1.json",
+		`{"variant":{"barcode":null,"id":1,"inventory_quantity":0,"price":"9.5","taxable":false}}`,
+		`{"variant":{"id":1,"taxable":false}}`)
+
+	patch := NewPatch[Variant]().
+		Set("taxable", false).
+		Set("inventory_quantity", 0).
+		Set("price", decimal.NewFromFloat(9.5)).
+		Clear("barcode")
+	variant, err := client.Variant.Patch(context.Background(), 1, patch)
+	if err != nil {
+		t.Fatalf("Variant.Patch returned error: %v", err)
+	}
+	if variant.ID != 1 {
+		t.Errorf("Variant.Patch returned %+v, expected the variant", variant)
+	}
+}
+
+func TestPatchServices(t *testing.T) {
+	setup()
+	defer teardown()
+	ctx := context.Background()
+
+	registerPatch(t, "products/1.json", `{"product":{"id":1,"template_suffix":""}}`, `{"product":{"id":1}}`)
+	if _, err := client.Product.Patch(ctx, 1, NewPatch[Product]().Set("template_suffix", "")); err != nil {
+		t.Errorf("Product.Patch returned error: %v", err)
+	}
+
+	registerPatch(t, "orders/1.json", `{"order":{"id":1,"note":null}}`, `{"order":{"id":1}}`)
+	if _, err := client.Order.Patch(ctx, 1, NewPatch[Order]().Clear("note")); err != nil {
+		t.Errorf("Order.Patch returned error: %v", err)
+	}
+
+	registerPatch(t, "customers/1.json", `{"customer":{"accepts_marketing":false,"id":1}}`, `{"customer":{"id":1}}`)
+	if _, err := client.Customer.Patch(ctx, 1, NewPatch[Customer]().SetFrom(Customer{}, "accepts_marketing")); err != nil {
+		t.Errorf("Customer.Patch returned error: %v", err)
+	}
+
+	registerPatch(t, "custom_collections/1.json", `{"custom_collection":{"id":1,"published":false}}`, `{"custom_collection":{"id":1}}`)
+	if _, err := client.CustomCollection.Patch(ctx, 1, NewPatch[CustomCollection]().Set("published", false)); err != nil {
+		t.Errorf("CustomCollection.Patch returned error: %v", err)
+	}
+
+	registerPatch(t, "smart_collections/1.json", `{"smart_collection":{"disjunctive":false,"id":1}}`, `{"smart_collection":{"id":1}}`)
+	if _, err := client.SmartCollection.Patch(ctx, 1, NewPatch[SmartCollection]().Set("disjunctive", false)); err != nil {
+		t.Errorf("SmartCollection.Patch returned error: %v", err)
+	}
+
+	registerPatch(t, "pages/1.json", `{"page":{"body_html":"","id":1}}`, `{"page":{"id":1}}`)
+	if _, err := client.Page.Patch(ctx, 1, NewPatch[Page]().Set("body_html", "")); err != nil {
+		t.Errorf("Page.Patch returned error: %v", err)
+	}
+
+	registerPatch(t, "blogs/2/articles/1.json", `{"article":{"id":1,"tags":""}}`, `{"article":{"id":1}}`)
+	article, err := client.Article.Patch(ctx, 2, 1, NewPatch[Article]().Set("tags", ""))
+	if err != nil || article.ID != 1 {
+		t.Errorf("Article.Patch returned %+v, %v, expected the article", article, err)
+	}
+}
+
+func TestPatchErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cases := []struct {
+		patch    *Patch[Variant]
+		expected string
+	}{
+		{NewPatch[Variant](), "patch Variant: no fields set"},
+		{nil, "patch Variant: no fields set"},
+		{NewPatch[Variant]().Set("taxabel", false), `patch Variant: unknown field "taxabel"`},
+		{NewPatch[Variant]().Set("taxable", "no"), `patch Variant: field "taxable" is bool, got string`},
+		{NewPatch[Variant]().Set("sku", 1).Clear("foo"), "patch Variant: field \"sku\" is string, got int\npatch Variant: unknown field \"foo\""},
+	}
+
+	for _, c := range cases {
+		_, err := client.Variant.Patch(context.Background(), 1, c.patch)
+		if err == nil || err.Error() != c.expected {
+			t.Errorf("Variant.Patch returned %v, expected %q", err, c.expected)
+		}
+	}
+	if calls := httpmock.GetTotalCallCount(); calls != 0 {
+		t.Errorf("expected no call, got %d", calls)
+	}
+}
+
+func TestPatchFields(t *testing.T) {
+	patch := NewPatch[Product]().Set("title", "foo").Set("id", int64(1)).Clear("body_html")
+	expected := []string{"body_html", "id", "title"}
+	if fields := patch.Fields(); fmt.Sprint(fields) != fmt.Sprint(expected) {
+		t.Errorf("Patch.Fields() = %v, expected %v", fields, expected)
+	}
+}
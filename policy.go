@@ -0,0 +1,46 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const policiesBasePath = "policies"
+
+// PolicyService is an interface for interfacing with the policy endpoints
+// of the Shopify API.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/policy
+type PolicyService interface {
+	List(context.Context) ([]Policy, error)
+}
+
+// PolicyServiceOp handles communication with the policy related methods of
+// the Shopify API.
+type PolicyServiceOp struct {
+	client *Client
+}
+
+// Policy represents a Shopify legal policy, e.g. the refund policy or the
+// terms of service
+type Policy struct {
+	Title     string     `json:"title,omitempty"`
+	Body      string     `json:"body,omitempty"`
+	Handle    string     `json:"handle,omitempty"`
+	Url       string     `json:"url,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// PoliciesResource represents the result from the policies.json endpoint
+type PoliciesResource struct {
+	Policies []Policy `json:"policies"`
+}
+
+// List policies
+func (s *PolicyServiceOp) List(ctx context.Context) ([]Policy, error) {
+	path := fmt.Sprintf("%s.json", policiesBasePath)
+	resource := new(PoliciesResource)
+	err := s.client.Get(ctx, path, resource, nil)
+	return resource.Policies, err
+}
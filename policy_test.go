@@ -0,0 +1,50 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestPolicyList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/policies.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("policies.json")))
+
+	policies, err := client.Policy.List(context.Background())
+	if err != nil {
+		t.Errorf("Policy.List returned error: %v", err)
+	}
+
+	if len(policies) != 1 {
+		t.Fatalf("Policy.List got %v policies, expected: 1", len(policies))
+	}
+
+	policy := policies[0]
+	cases := []struct {
+		field    string
+		expected interface{}
+		actual   interface{}
+	}{
+		{"Title", "Refund policy", policy.Title},
+		{"Body", "You have 30 days to return your items.", policy.Body},
+		{"Handle", "refund-policy", policy.Handle},
+		{"Url", "https://checkout.shopify.com/548380009/policies/878590288.html?locale=en", policy.Url},
+	}
+
+	for _, c := range cases {
+		if c.expected != c.actual {
+			t.Errorf("Policy.%v returned %v, expected %v", c.field, c.actual, c.expected)
+		}
+	}
+
+	d := time.Date(2024, time.January, 2, 14, 28, 43, 0, time.UTC)
+	if !d.Equal(*policy.CreatedAt) {
+		t.Errorf("Policy.CreatedAt returned %+v, expected %+v", policy.CreatedAt, d)
+	}
+}
@@ -23,10 +23,13 @@ var linkRegex = regexp.MustCompile(`^ *<([^>]+)>; rel="(previous|next)" *$`)
 type ProductService interface {
 	List(context.Context, interface{}) ([]Product, error)
 	ListWithPagination(context.Context, interface{}) ([]Product, *Pagination, error)
+	StreamProducts(context.Context, interface{}, func(Product) error) error
 	Count(context.Context, interface{}) (int, error)
 	Get(context.Context, int64, interface{}) (*Product, error)
+	GetMany(context.Context, []int64) (*GetManyResult[Product], error)
 	Create(context.Context, Product) (*Product, error)
 	Update(context.Context, Product) (*Product, error)
+	Patch(context.Context, int64, *Patch[Product]) (*Product, error)
 	Delete(context.Context, int64) error
 
 	// MetafieldsService used for Product resource to communicate with Metafields resource
@@ -140,6 +143,18 @@ func (s *ProductServiceOp) ListWithPagination(ctx context.Context, options inter
 	return resource.Products, pagination, nil
 }
 
+// StreamProducts lists products across all pages and calls fn with each
+// product as it is decoded, without holding a whole page in memory.
+// It stops at the first error returned by fn and returns that error.
+// fn runs while the response body is still being read, so the time spent in
+// fn counts toward the Timeout of the http client and a slow fn fails the
+// page with a body read timeout. Hand the products off, e.g. to a buffered
+// channel, rather than doing slow work in fn.
+func (s *ProductServiceOp) StreamProducts(ctx context.Context, options interface{}, fn func(Product) error) error {
+	path := fmt.Sprintf("%s.json", productsBasePath)
+	return streamList(ctx, s.client, path, "products", options, fn)
+}
+
 // extractPagination extracts pagination info from linkHeader.
 // Details on the format are here:
 // https://help.shopify.com/en/api/guides/paginated-rest-results
@@ -219,6 +234,14 @@ func (s *ProductServiceOp) Get(ctx context.Context, productID int64, options int
 	return resource.Product, err
 }
 
+// GetMany gets products by id, in chunks of 250 ids
+func (s *ProductServiceOp) GetMany(ctx context.Context, ids []int64) (*GetManyResult[Product], error) {
+	path := fmt.Sprintf("%s.json", productsBasePath)
+	return getMany(ctx, s.client, path, "products", ids, getManyChunkSize, "", func(resource Product) int64 {
+		return resource.ID
+	})
+}
+
 // Create a new product
 func (s *ProductServiceOp) Create(ctx context.Context, product Product) (*Product, error) {
 	path := fmt.Sprintf("%s.json", productsBasePath)
@@ -237,6 +260,19 @@ func (s *ProductServiceOp) Update(ctx context.Context, product Product) (*Produc
 	return resource.Product, err
 }
 
+// Patch updates only the fields set in the patch of an existing product
+func (s *ProductServiceOp) Patch(ctx context.Context, productID int64, patch *Patch[Product]) (*Product, error) {
+	body, err := patch.body(productID)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("%s/%d.json", productsBasePath, productID)
+	wrappedData := map[string]interface{}{"product": body}
+	resource := new(ProductResource)
+	err = s.client.Put(ctx, path, wrappedData, resource)
+	return resource.Product, err
+}
+
 // Delete an existing product
 func (s *ProductServiceOp) Delete(ctx context.Context, productID int64) error {
 	return s.client.Delete(ctx, fmt.Sprintf("%s/%d.json", productsBasePath, productID))
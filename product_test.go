@@ -2,6 +2,7 @@ package goshopify
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -440,3 +441,30 @@ func TestProductDeleteMetafield(t *testing.T) {
 		t.Errorf("Product.DeleteMetafield() returned error: %v", err)
 	}
 }
+
+func BenchmarkProductList(b *testing.B) {
+	setup()
+	defer teardown()
+
+	product := map[string]interface{}{}
+	if err := json.Unmarshal(loadFixture("product.json"), &product); err != nil {
+		b.Fatal(err)
+	}
+	products := make([]interface{}, 250)
+	for i := range products {
+		products[i] = product["product"]
+	}
+	body, _ := json.Marshal(map[string]interface{}{"products": products})
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, body))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := client.Product.List(context.Background(), nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package goshopify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ErrReadOnly matches the ReadOnlyError of the writes refused by a client
+// made with WithReadOnly
+var ErrReadOnly = errors.New("shopify: client is read-only")
+
+// ReadOnlyError is returned for a POST, PUT, PATCH or DELETE request or a
+// GraphQL mutation of a client made with WithReadOnly, before anything is
+// sent to Shopify
+type ReadOnlyError struct {
+	Method string
+
+	// Path is the resource path including the api prefix
+	Path string
+}
+
+func (e ReadOnlyError) Error() string {
+	return fmt.Sprintf("shopify: %s %s refused, client is read-only", e.Method, e.Path)
+}
+
+// Is makes ReadOnlyError match ErrReadOnly
+func (e ReadOnlyError) Is(target error) bool {
+	return target == ErrReadOnly
+}
+
+// checkWrite refuses or simulates a write request in read-only and dry-run
+// modes, handled is set if the request must not be sent
+func (c *Client) checkWrite(req *http.Request, v interface{}) (headers http.Header, handled bool, err error) {
+	if !c.readOnly && !c.dryRun {
+		return nil, false, nil
+	}
+	body, err := requestBody(req)
+	if err != nil {
+		return nil, true, err
+	}
+	_, path := c.requestTarget(req.URL)
+	if !isWrite(req.Method, path, body) {
+		return nil, false, nil
+	}
+
+	if c.readOnly {
+		return nil, true, ReadOnlyError{Method: req.Method, Path: path}
+	}
+
+	logged := body
+	if c.redactor != nil {
+		logged = c.redactor.RedactBody(body)
+	}
+	c.log.Infof("dry run %s %s %s", req.Method, path, bytes.TrimSpace(logged))
+
+	// the payload is echoed as the response of Shopify
+	if v != nil && len(bytes.TrimSpace(body)) > 0 {
+		if err := json.Unmarshal(body, &v); err != nil {
+			return nil, true, fmt.Errorf("decode response: %w", err)
+		}
+	}
+	return http.Header{"X-Shopify-Dry-Run": {"true"}}, true, nil
+}
+
+// requestBody returns the body of a request and sets it again to be sent
+func requestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// isWrite reports whether a request changes data, the POST requests to a
+// graphql.json path only do if they are mutations or subscriptions. A GraphQL
+// query that can't be parsed is a write.
+func isWrite(method, path string, body []byte) bool {
+	switch {
+	case method == http.MethodGet, method == http.MethodHead, method == http.MethodOptions:
+		return false
+	case method == http.MethodPost && (path == "graphql.json" || strings.HasSuffix(path, "/graphql.json")):
+		var graphql struct {
+			Query         string `json:"query"`
+			OperationName string `json:"operationName"`
+		}
+		if json.Unmarshal(body, &graphql) == nil && graphql.Query != "" {
+			return !isGraphQLRead(graphql.Query, graphql.OperationName)
+		}
+	}
+	return true
+}
+
+// graphQLOperation is an operation of a GraphQL document
+type graphQLOperation struct {
+	kind string
+	name string
+}
+
+// isGraphQLRead reports whether a GraphQL document only has queries and the
+// operation to run is one of them. The document is scanned rather than
+// validated, the comments and strings are skipped and only the definitions
+// at the top level are looked at.
+func isGraphQLRead(query, operationName string) bool {
+	operations, ok := graphQLOperations(query)
+	if !ok || len(operations) == 0 {
+		return false
+	}
+	for _, op := range operations {
+		if op.kind != "query" {
+			return false
+		}
+	}
+	if operationName == "" {
+		// a document with several operations needs an operationName
+		return len(operations) == 1
+	}
+	for _, op := range operations {
+		if op.name == operationName {
+			return true
+		}
+	}
+	return false
+}
+
+// graphQLOperations returns the operations of a GraphQL document, the
+// fragments are left out. ok is false if the document can't be parsed.
+func graphQLOperations(query string) (operations []graphQLOperation, ok bool) {
+	var (
+		// the unclosed brackets
+		stack []byte
+		// a definition is expected, i.e. at the start and after a selection set
+		// at the top level
+		definition = true
+		// the name of the current operation may be next
+		named    bool
+		fragment bool
+	)
+
+	for i := 0; i < len(query); {
+		ch := query[i]
+		switch {
+		case ch == '#':
+			for i < len(query) && query[i] != '\n' && query[i] != '\r' {
+				i++
+			}
+			continue
+		case strings.HasPrefix(query[i:], `"""`):
+			end := strings.Index(strings.ReplaceAll(query[i+3:], `\"""`, "xxxx"), `"""`)
+			if end < 0 {
+				return nil, false
+			}
+			i += 3 + end + 3
+			named = false
+			continue
+		case ch == '"':
+			i++
+			for i < len(query) && query[i] != '"' {
+				if query[i] == '\\' {
+					i++
+				}
+				if i < len(query) && (query[i] == '\n' || query[i] == '\r') {
+					return nil, false
+				}
+				i++
+			}
+			if i >= len(query) {
+				return nil, false
+			}
+			i++
+			named = false
+			continue
+		case ch == '_' || ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z':
+			start := i
+			for i < len(query) && (query[i] == '_' || query[i] >= 'a' && query[i] <= 'z' ||
+				query[i] >= 'A' && query[i] <= 'Z' || query[i] >= '0' && query[i] <= '9') {
+				i++
+			}
+			word := query[start:i]
+			if len(stack) > 0 {
+				continue
+			}
+			switch {
+			case definition:
+				switch word {
+				case "query", "mutation", "subscription":
+					operations = append(operations, graphQLOperation{kind: word})
+					fragment = false
+				case "fragment":
+					fragment = true
+				default:
+					return nil, false
+				}
+				definition = false
+				named = true
+			case named && !fragment:
+				operations[len(operations)-1].name = word
+				named = false
+			}
+			continue
+		}
+
+		switch ch {
+		case '{', '(', '[':
+			if len(stack) == 0 && ch == '{' && definition {
+				// the query shorthand
+				operations = append(operations, graphQLOperation{kind: "query"})
+				definition = false
+			}
+			stack = append(stack, ch)
+		case '}', ')', ']':
+			opening := map[byte]byte{'}': '{', ')': '(', ']': '['}[ch]
+			if len(stack) == 0 || stack[len(stack)-1] != opening {
+				return nil, false
+			}
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 && ch == '}' {
+				definition = true
+			}
+		case ' ', '\t', '\n', '\r', ',':
+			i++
+			continue
+		}
+		if len(stack) == 0 && definition && ch != '}' {
+			// anything but a definition at the top level
+			return nil, false
+		}
+		named = false
+		i++
+	}
+	return operations, len(stack) == 0 && definition
+}
@@ -0,0 +1,149 @@
+package goshopify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestReadOnly(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client = NewClient(app, "fooshop", "abcd", WithVersion(testApiVersion), WithReadOnly())
+	httpmock.ActivateNonDefault(client.Client)
+	ctx := context.Background()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products/1.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"product":{"id":1}}`))
+	if _, err := client.Product.Get(ctx, 1, nil); err != nil {
+		t.Errorf("Product.Get returned error: %v", err)
+	}
+
+	_, err := client.Product.Create(ctx, Product{Title: "foo"})
+	expected := ReadOnlyError{Method: "POST", Path: fmt.Sprintf("%s/products.json", client.pathPrefix)}
+	if err != expected || !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Product.Create returned %v, expected %v", err, expected)
+	}
+	_, err = client.Product.CreateMetafield(ctx, 1, Metafield{Key: "foo"})
+	if !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Product.CreateMetafield returned %v, expected a ReadOnlyError", err)
+	}
+	_, err = client.Order.CreateFulfillment(ctx, 1, Fulfillment{})
+	if !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Order.CreateFulfillment returned %v, expected a ReadOnlyError", err)
+	}
+	if err := client.Product.Delete(ctx, 1); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Product.Delete returned %v, expected a ReadOnlyError", err)
+	}
+
+	// only the GraphQL queries are reads, not the REST bodies with a query key
+	query := map[string]interface{}{"query": "{ shop { id } }", "product": Product{Title: "foo"}}
+	if err := client.Post(ctx, "products.json", query, nil); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Post products.json returned %v, expected a ReadOnlyError", err)
+	}
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/graphql.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"data":{"shop":{"id":1}}}`))
+	if err := client.Post(ctx, "graphql.json", map[string]string{"query": "{ shop { id } }"}, nil); err != nil {
+		t.Errorf("Post graphql.json returned error: %v", err)
+	}
+
+	if calls := httpmock.GetTotalCallCount(); calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	setup()
+	defer teardown()
+
+	out := &bytes.Buffer{}
+	client = NewClient(app, "fooshop", "abcd", WithVersion(testApiVersion), WithDryRun(),
+		WithLogger(&LeveledLogger{Level: LevelInfo, stdoutOverride: out}))
+	httpmock.ActivateNonDefault(client.Client)
+	ctx := context.Background()
+
+	product, err := client.Product.Create(ctx, Product{Title: "foo"})
+	if err != nil {
+		t.Fatalf("Product.Create returned error: %v", err)
+	}
+	if product.Title != "foo" {
+		t.Errorf("Product.Create returned %+v, expected the payload", product)
+	}
+
+	metafield, err := client.Customer.UpdateMetafield(ctx, 1, Metafield{ID: 2, Value: "bar"})
+	if err != nil {
+		t.Fatalf("Customer.UpdateMetafield returned error: %v", err)
+	}
+	if metafield.ID != 2 || metafield.Value != "bar" {
+		t.Errorf("Customer.UpdateMetafield returned %+v, expected the payload", metafield)
+	}
+
+	if err := client.Product.Delete(ctx, 1); err != nil {
+		t.Errorf("Product.Delete returned error: %v", err)
+	}
+
+	if calls := httpmock.GetTotalCallCount(); calls != 0 {
+		t.Errorf("expected no call, got %d", calls)
+	}
+
+	logged := out.String()
+	for _, expected := range []string{
+		fmt.Sprintf(`[INFO] dry run POST %s/products.json {"product":{"title":"foo"`, client.pathPrefix),
+		fmt.Sprintf("[INFO] dry run PUT %s/customers/1/metafields/2.json", client.pathPrefix),
+		fmt.Sprintf("[INFO] dry run DELETE %s/products/1.json", client.pathPrefix),
+	} {
+		if !strings.Contains(logged, expected) {
+			t.Errorf("dry run logged %q, expected %q", logged, expected)
+		}
+	}
+}
+
+func TestIsWrite(t *testing.T) {
+	graphqlPath := "admin/api/2024-01/graphql.json"
+	productsPath := "admin/api/2024-01/products.json"
+	cases := []struct {
+		method   string
+		path     string
+		body     string
+		expected bool
+	}{
+		{"GET", productsPath, "", false},
+		{"POST", productsPath, `{"product":{"title":"foo"}}`, true},
+		{"PUT", productsPath, `{"product":{"id":1}}`, true},
+		{"DELETE", productsPath, "", true},
+		{"POST", graphqlPath, `{"query":"{ shop { name } }"}`, false},
+		{"POST", graphqlPath, `{"query":"query Shop { shop { name } }"}`, false},
+		{"POST", graphqlPath, `{"query":" mutation cartCreate { cartCreate { cart { id } } }"}`, true},
+		{"POST", graphqlPath, `{"query":"# comment\nmutation { productDelete(input: {id: 1}) { deletedProductId } }"}`, true},
+		{"POST", graphqlPath, `{"query":"fragment F on Product { id } mutation M { productCreate { product { ...F } } }"}`, true},
+		{"POST", graphqlPath, `{"query":"fragment F on Product { id } query Q { product(id: 1) { ...F } }"}`, false},
+		{"POST", graphqlPath, `{"query":"query Q { shop { name } } mutation M { shopUpdate { shop { id } } }","operationName":"M"}`, true},
+		{"POST", graphqlPath, `{"query":"query Q { shop { name } } mutation M { shopUpdate { shop { id } } }","operationName":"Q"}`, true},
+		{"POST", graphqlPath, `{"query":"query Q { shop { name } } query R { shop { id } }","operationName":"R"}`, false},
+		{"POST", graphqlPath, `{"query":"query Q { shop { name } } query R { shop { id } }"}`, true},
+		{"POST", graphqlPath, `{"query":"query Q { shop { name } }","operationName":"M"}`, true},
+		{"POST", graphqlPath, `{"query":"{ shop { name } } mutation { shopUpdate { shop { id } } }"}`, true},
+		{"POST", graphqlPath, `{"query":"\n\t, mutation { shopUpdate { shop { id } } }"}`, true},
+		{"POST", graphqlPath, `{"query":"subscription { orderCreated { id } }"}`, true},
+		{"POST", graphqlPath, `{"query":"query Q($q: String = \"} mutation {\") { products(query: $q) { id } }"}`, false},
+		{"POST", graphqlPath, `{"query":"{ shop { name } "}`, true},
+		{"POST", graphqlPath, `{"query":"shop { name }"}`, true},
+		{"POST", graphqlPath, `[{"query":"{ shop { name } }"}]`, true},
+		{"POST", "graphql.json", `{"query":"{ shop { name } }"}`, false},
+		{"POST", productsPath, `{"query":"{ shop { name } }","product":{"title":"foo"}}`, true},
+		{"POST", "admin/api/2024-01/foographql.json", `{"query":"{ shop { name } }"}`, true},
+		{"PUT", graphqlPath, `{"query":"{ shop { name } }"}`, true},
+	}
+
+	for _, c := range cases {
+		if actual := isWrite(c.method, c.path, []byte(c.body)); actual != c.expected {
+			t.Errorf("isWrite(%s, %s, %s) = %v, expected %v", c.method, c.path, c.body, actual, c.expected)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package goshopify
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// defaultRedactMask replaces redacted values
+const defaultRedactMask = "[REDACTED]"
+
+// emailRegex matches email addresses in free text, e.g. order notes
+var emailRegex = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+// DefaultRedactKeys are the JSON keys masked by NewRedactor: access tokens
+// and secrets, emails, phones, addresses, personal names, notes and payment
+// details. Address objects are masked whole, with the names they carry.
+var DefaultRedactKeys = []string{
+	// tokens and secrets
+	"access_token", "token", "password", "api_key", "api_secret", "secret", "hmac", "signature",
+	"multipass_identifier", "client_secret",
+	// emails
+	"email", "customer_email", "contact_email",
+	// phones
+	"phone",
+	// addresses and personal names
+	"billing_address", "shipping_address", "default_address", "addresses", "customer_address",
+	"first_name", "last_name", "company", "address1", "address2", "city", "zip",
+	"latitude", "longitude", "browser_ip", "customer_locale",
+	// free text written by customers
+	"note", "note_attributes",
+	// payment details
+	"payment_details", "credit_card_number", "credit_card_bin", "credit_card_name",
+	"credit_card_expiration_month", "credit_card_expiration_year", "avs_result_code",
+	"cvv_result_code",
+}
+
+// Redactor masks personal data and secrets in logged request and response
+// bodies. Values of configured JSON keys are masked at any depth and email
+// addresses are masked in every string value.
+type Redactor struct {
+	// Mask replaces redacted values, defaults to "[REDACTED]"
+	Mask string
+
+	keys map[string]struct{}
+}
+
+// NewRedactor returns a Redactor masking DefaultRedactKeys and the given
+// additional keys. Keys are matched case-insensitively.
+func NewRedactor(keys ...string) *Redactor {
+	r := &Redactor{
+		Mask: defaultRedactMask,
+		keys: map[string]struct{}{},
+	}
+	for _, key := range append(append([]string{}, DefaultRedactKeys...), keys...) {
+		r.keys[strings.ToLower(key)] = struct{}{}
+	}
+	return r
+}
+
+// RedactBody returns a copy of a JSON body with sensitive values masked.
+// Bodies which are not JSON only have their email addresses masked.
+func (r *Redactor) RedactBody(body []byte) []byte {
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return emailRegex.ReplaceAll(body, []byte(r.mask()))
+	}
+
+	redacted, err := json.Marshal(r.redactValue(v))
+	if err != nil {
+		return []byte(r.mask())
+	}
+	return redacted
+}
+
+// RedactURL returns the URL with sensitive query values and email addresses
+// masked, e.g. the query of customers/search.json
+func (r *Redactor) RedactURL(u *url.URL) string {
+	redacted := *u
+	query := redacted.Query()
+	for key, values := range query {
+		for i, value := range values {
+			if r.isSensitive(key) {
+				values[i] = r.mask()
+			} else {
+				values[i] = emailRegex.ReplaceAllString(value, r.mask())
+			}
+		}
+	}
+	redacted.RawQuery = query.Encode()
+	return redacted.String()
+}
+
+func (r *Redactor) redactValue(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for key, elem := range value {
+			if elem != nil && r.isSensitive(key) {
+				value[key] = r.mask()
+			} else {
+				value[key] = r.redactValue(elem)
+			}
+		}
+	case []interface{}:
+		for i, elem := range value {
+			value[i] = r.redactValue(elem)
+		}
+	case string:
+		return emailRegex.ReplaceAllString(value, r.mask())
+	}
+	return v
+}
+
+func (r *Redactor) isSensitive(key string) bool {
+	_, ok := r.keys[strings.ToLower(key)]
+	return ok
+}
+
+func (r *Redactor) mask() string {
+	if r.Mask == "" {
+		return defaultRedactMask
+	}
+	return r.Mask
+}
@@ -0,0 +1,118 @@
+package goshopify
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRedactorRedactBody(t *testing.T) {
+	body := []byte(`{
+		"customer": {
+			"id": 207119551,
+			"email": "bob.norman@mail.example.com",
+			"first_name": "Bob",
+			"note": "call bob at bob@example.com",
+			"total_spent": 199.65,
+			"tags": "call bob at bob@example.com",
+			"default_address": {"address1": "Chestnut Street 92", "country": "United States", "phone": "555-625-1199"},
+			"addresses": [{"zip": "40202", "province": "Kentucky"}],
+			"payment_details": {"credit_card_number": "•••• 4242"},
+			"verified_email": true,
+			"last_name": null
+		},
+		"access_token": "shpat_secret"
+	}`)
+
+	redacted := NewRedactor().RedactBody(body)
+
+	actual := map[string]interface{}{}
+	if err := json.Unmarshal(redacted, &actual); err != nil {
+		t.Fatalf("RedactBody returned invalid JSON: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"customer": map[string]interface{}{
+			"id":              float64(207119551),
+			"email":           "[REDACTED]",
+			"first_name":      "[REDACTED]",
+			"note":            "[REDACTED]",
+			"total_spent":     199.65,
+			"tags":            "call bob at [REDACTED]",
+			"default_address": "[REDACTED]",
+			"addresses":       "[REDACTED]",
+			"payment_details": "[REDACTED]",
+			"verified_email":  true,
+			"last_name":       nil,
+		},
+		"access_token": "[REDACTED]",
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("RedactBody returned %s, expected %+v", redacted, expected)
+	}
+}
+
+func TestRedactorRedactBodyNotJSON(t *testing.T) {
+	r := NewRedactor()
+	r.Mask = "***"
+
+	redacted := string(r.RedactBody([]byte("<html>contact bob@example.com</html>")))
+	expected := "<html>contact ***</html>"
+	if redacted != expected {
+		t.Errorf("RedactBody returned %s, expected %s", redacted, expected)
+	}
+}
+
+func TestRedactorAdditionalKeys(t *testing.T) {
+	redacted := string(NewRedactor("Note").RedactBody([]byte(`{"note":"private","title":"public"}`)))
+	expected := `{"note":"[REDACTED]","title":"public"}`
+	if redacted != expected {
+		t.Errorf("RedactBody returned %s, expected %s", redacted, expected)
+	}
+}
+
+func TestRedactorRedactURL(t *testing.T) {
+	u, _ := url.Parse("https://fooshop.myshopify.com/admin/customers/search.json?query=email:bob@example.com&access_token=secret&limit=5")
+
+	redacted := NewRedactor().RedactURL(u)
+	if strings.Contains(redacted, "bob@example.com") || strings.Contains(redacted, "secret") {
+		t.Errorf("RedactURL returned %s, expected email and token to be masked", redacted)
+	}
+	if !strings.Contains(redacted, "limit=5") {
+		t.Errorf("RedactURL returned %s, expected limit to be kept", redacted)
+	}
+	if u.RawQuery != "query=email:bob@example.com&access_token=secret&limit=5" {
+		t.Errorf("RedactURL modified the original URL: %s", u.String())
+	}
+}
+
+func TestRedactorRedactOrder(t *testing.T) {
+	body := loadFixture("order.json")
+	var order struct {
+		Order map[string]interface{} `json:"order"`
+	}
+	if err := json.Unmarshal(body, &order); err != nil {
+		t.Fatal(err)
+	}
+	order.Order["note"] = "leave it with the neighbour"
+	order.Order["customer_locale"] = "fr-CA"
+	body, _ = json.Marshal(order)
+
+	redacted := string(NewRedactor().RedactBody(body))
+
+	// the personal data of the order fixture
+	for _, value := range []string{
+		"Bob Biller", "Steve Shipper", "Billtown", "K2P0S0", "Kentucky", "555-555-BILL",
+		"jon@doe.ca", "leave it with the neighbour", "fr-CA",
+	} {
+		if strings.Contains(redacted, value) {
+			t.Errorf("RedactBody of an order kept %q", value)
+		}
+	}
+	if !strings.Contains(redacted, `"name":"#9999"`) || !strings.Contains(redacted, `"total_price":"10.00"`) {
+		t.Errorf("RedactBody of an order removed the order name or price: %s", redacted)
+	}
+}
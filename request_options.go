@@ -0,0 +1,105 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type requestOptionsKey struct{}
+
+// RequestOption overrides the configuration of the client for the calls made
+// with a context, see WithRequestOptions
+type RequestOption func(o *requestOptions)
+
+type requestOptions struct {
+	timeout    time.Duration
+	retries    *int
+	apiVersion string
+	header     http.Header
+}
+
+// WithRequestOptions returns a context overriding the configuration of the
+// client for the calls made with it, e.g. a longer timeout for a big upload
+//
+//	ctx = goshopify.WithRequestOptions(ctx, goshopify.RequestTimeout(time.Minute))
+//	asset, err := client.Asset.Update(ctx, themeID, asset)
+//
+// Options of an outer context are kept unless overridden.
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	o := requestOptionsFrom(ctx)
+	o.header = o.header.Clone()
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return context.WithValue(ctx, requestOptionsKey{}, o)
+}
+
+// RequestTimeout replaces the timeout of the http client for each attempt
+func RequestTimeout(timeout time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = timeout
+	}
+}
+
+// RequestRetries replaces the retries set with WithRetry, 0 disables the
+// retries, e.g. for calls which are not idempotent
+func RequestRetries(retries int) RequestOption {
+	return func(o *requestOptions) {
+		o.retries = &retries
+	}
+}
+
+// RequestVersion replaces the api version set with WithVersion, e.g.
+// "unstable" for a preview endpoint. Invalid versions are ignored.
+func RequestVersion(apiVersion string) RequestOption {
+	return func(o *requestOptions) {
+		if apiVersionRegex.MatchString(apiVersion) || apiVersion == UnstableApiVersion {
+			o.apiVersion = apiVersion
+		}
+	}
+}
+
+// RequestHeader sets a header on the requests, replacing the value set by the
+// client if any
+func RequestHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		if o.header == nil {
+			o.header = http.Header{}
+		}
+		o.header.Set(key, value)
+	}
+}
+
+// requestOptionsFrom returns the request options of a context
+func requestOptionsFrom(ctx context.Context) requestOptions {
+	if ctx == nil {
+		return requestOptions{}
+	}
+	o, _ := ctx.Value(requestOptionsKey{}).(requestOptions)
+	return o
+}
+
+// requestVersion returns the api version and path prefix of the calls made
+// with a context
+func (c *Client) requestVersion(ctx context.Context) (apiVersion, pathPrefix string) {
+	if o := requestOptionsFrom(ctx); o.apiVersion != "" {
+		return o.apiVersion, fmt.Sprintf("admin/api/%s", o.apiVersion)
+	}
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	return c.apiVersion, c.pathPrefix
+}
+
+// httpClient returns the http client sending a request, a copy of the client
+// with another timeout if the request overrides it
+func (c *Client) httpClient(req *http.Request) *http.Client {
+	o := requestOptionsFrom(req.Context())
+	if o.timeout <= 0 {
+		return c.Client
+	}
+	client := *c.Client
+	client.Timeout = o.timeout
+	return &client
+}
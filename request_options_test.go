@@ -0,0 +1,138 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestRequestVersionAndHeader(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", "https://fooshop.myshopify.com/admin/api/unstable/products/1.json",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("X-Preview") != "on" || req.Header.Get("Accept") != "application/vnd+json" {
+				return httpmock.NewStringResponse(400, `{"errors":"missing headers"}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"product":{"id":1}}`), nil
+		})
+
+	ctx := WithRequestOptions(context.Background(), RequestVersion(UnstableApiVersion), RequestHeader("X-Preview", "on"))
+	ctx = WithRequestOptions(ctx, RequestHeader("Accept", "application/vnd+json"), RequestVersion("invalid"))
+
+	product, err := client.Product.Get(ctx, 1, nil)
+	if err != nil {
+		t.Fatalf("Product.Get returned error: %v", err)
+	}
+	if product.ID != 1 {
+		t.Errorf("Product.Get returned %+v, expected the product", product)
+	}
+
+	// the client configuration is unchanged for the other calls
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/products/1.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("X-Preview") != "" {
+				return httpmock.NewStringResponse(400, `{"errors":"unexpected header"}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"product":{"id":1}}`), nil
+		})
+	if _, err := client.Product.Get(context.Background(), 1, nil); err != nil {
+		t.Errorf("Product.Get returned error: %v", err)
+	}
+}
+
+func TestRequestRetries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			calls++
+			return httpmock.NewStringResponse(503, ""), nil
+		})
+
+	ctx := WithRequestOptions(context.Background(), RequestRetries(0))
+	_, err := client.Order.Create(ctx, Order{})
+	if err == nil || calls != 1 {
+		t.Errorf("Order.Create returned %v after %d calls, expected an error after 1 call", err, calls)
+	}
+
+	calls = 0
+	_, err = client.Order.Create(context.Background(), Order{})
+	if err == nil || calls != maxRetries {
+		t.Errorf("Order.Create returned %v after %d calls, expected an error after %d calls", err, calls, maxRetries)
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shop.json", client.pathPrefix),
+		func(req *http.Request) (*http.Response, error) {
+			select {
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			case <-time.After(time.Second):
+				return httpmock.NewBytesResponse(200, loadFixture("shop.json")), nil
+			}
+		})
+
+	ctx := WithRequestOptions(context.Background(), RequestTimeout(10*time.Millisecond))
+	_, err := client.Shop.Get(ctx, nil)
+	if err == nil {
+		t.Errorf("Shop.Get returned no error, expected a timeout")
+	}
+
+	req, _ := client.NewRequest(ctx, "GET", "shop.json", nil, nil)
+	if timeout := client.httpClient(req).Timeout; timeout != 10*time.Millisecond {
+		t.Errorf("httpClient timeout = %s, expected 10ms", timeout)
+	}
+	req, _ = client.NewRequest(context.Background(), "GET", "shop.json", nil, nil)
+	if client.httpClient(req) != client.Client {
+		t.Errorf("httpClient returned a copy, expected the client's http client")
+	}
+}
+
+func TestRequestVersionConcurrent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// without a version the client uses the one served by the first response
+	client = NewClient(app, "fooshop", "abcd")
+	httpmock.ActivateNonDefault(client.Client)
+	httpmock.RegisterRegexpResponder("GET", regexp.MustCompile(`/products/1\.json$`),
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{"product":{"id":1}}`)
+			resp.Header.Set("X-Shopify-API-Version", "2024-01")
+			return resp, nil
+		})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		ctx := context.Background()
+		if i%2 == 0 {
+			ctx = WithRequestOptions(ctx, RequestVersion(UnstableApiVersion))
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Product.Get(ctx, 1, nil); err != nil {
+				t.Errorf("Product.Get returned error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if apiVersion, _ := client.requestVersion(context.Background()); apiVersion != "2024-01" {
+		t.Errorf("client api version = %s, expected 2024-01", apiVersion)
+	}
+}
@@ -0,0 +1,104 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+)
+
+// StructuredLoggerInterface is a LeveledLoggerInterface which also accepts
+// structured attributes. When the client logger implements it, every request
+// attempt is logged with its shop, method, path, status, duration and
+// attempt fields.
+type StructuredLoggerInterface interface {
+	LeveledLoggerInterface
+	LogAttrs(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr)
+}
+
+// SlogLogger adapts a *slog.Logger to LeveledLoggerInterface and
+// StructuredLoggerInterface
+type SlogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger returns a logger writing to the given slog logger, or to
+// slog.Default() if it is nil
+func NewSlogLogger(logger *slog.Logger) *SlogLogger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SlogLogger{logger: logger}
+}
+
+// DebugEnabled reports whether the slog logger handles debug messages.
+func (l *SlogLogger) DebugEnabled() bool {
+	return l.logger.Enabled(context.Background(), slog.LevelDebug)
+}
+
+// Debugf logs a debug message using Printf conventions.
+func (l *SlogLogger) Debugf(format string, v ...interface{}) {
+	l.logf(slog.LevelDebug, format, v...)
+}
+
+// Errorf logs an error message using Printf conventions.
+func (l *SlogLogger) Errorf(format string, v ...interface{}) {
+	l.logf(slog.LevelError, format, v...)
+}
+
+// Infof logs an informational message using Printf conventions.
+func (l *SlogLogger) Infof(format string, v ...interface{}) {
+	l.logf(slog.LevelInfo, format, v...)
+}
+
+// Warnf logs a warning message using Printf conventions.
+func (l *SlogLogger) Warnf(format string, v ...interface{}) {
+	l.logf(slog.LevelWarn, format, v...)
+}
+
+// LogAttrs logs a message with structured attributes.
+func (l *SlogLogger) LogAttrs(ctx context.Context, level slog.Level, msg string, attrs ...slog.Attr) {
+	l.logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+func (l *SlogLogger) logf(level slog.Level, format string, v ...interface{}) {
+	ctx := context.Background()
+	// skip formatting when the level is disabled
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+	l.logger.Log(ctx, level, fmt.Sprintf(format, v...))
+}
+
+// logAttempt logs a request attempt with structured fields if the logger
+// supports them
+func (c *Client) logAttempt(ctx context.Context, m RequestMetrics) {
+	logger, ok := c.log.(StructuredLoggerInterface)
+	if !ok {
+		return
+	}
+
+	level := slog.LevelDebug
+	switch {
+	case m.Err != nil || m.Status >= http.StatusInternalServerError:
+		level = slog.LevelError
+	case m.Status == http.StatusTooManyRequests || m.Status == 430:
+		level = slog.LevelWarn
+	}
+
+	attrs := []slog.Attr{
+		slog.String("shop", m.Shop),
+		slog.String("method", m.Method),
+		slog.String("path", m.Path),
+		slog.Int("status", m.Status),
+		slog.Duration("duration", m.Latency),
+		slog.Int("attempt", m.Attempt),
+	}
+	if m.ThrottleWait > 0 {
+		attrs = append(attrs, slog.Duration("throttle_wait", m.ThrottleWait))
+	}
+	if m.Err != nil {
+		attrs = append(attrs, slog.String("error", m.Err.Error()))
+	}
+	logger.LogAttrs(ctx, level, "shopify request", attrs...)
+}
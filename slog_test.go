@@ -0,0 +1,111 @@
+package goshopify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestSlogLogger(t *testing.T) {
+	out := &bytes.Buffer{}
+	logger := NewSlogLogger(slog.New(slog.NewTextHandler(out, &slog.HandlerOptions{Level: slog.LevelInfo})))
+
+	logger.Debugf("debug %s", "log")
+	logger.Infof("info %s", "log")
+	logger.Warnf("warn %s", "log")
+	logger.Errorf("error %s", "log")
+
+	logged := out.String()
+	if strings.Contains(logged, "debug log") {
+		t.Errorf("SlogLogger logged a disabled debug message: %s", logged)
+	}
+	for _, expected := range []string{`level=INFO msg="info log"`, `level=WARN msg="warn log"`, `level=ERROR msg="error log"`} {
+		if !strings.Contains(logged, expected) {
+			t.Errorf("SlogLogger output %q does not contain %q", logged, expected)
+		}
+	}
+
+	if NewSlogLogger(nil).logger != slog.Default() {
+		t.Errorf("NewSlogLogger(nil) expected to use slog.Default()")
+	}
+}
+
+func TestSlogLoggerRequestAttrs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	out := &bytes.Buffer{}
+	client.log = NewSlogLogger(slog.New(slog.NewJSONHandler(out, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/shop.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("shop.json")))
+
+	_, err := client.Shop.Get(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Shop.Get returned error: %v", err)
+	}
+
+	var record map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		entry := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("invalid log line %s: %v", line, err)
+		}
+		if entry["msg"] == "shopify request" {
+			record = entry
+		}
+	}
+	if record == nil {
+		t.Fatalf("no shopify request record logged: %s", out.String())
+	}
+
+	expected := map[string]interface{}{
+		"shop":    "fooshop.myshopify.com",
+		"method":  "GET",
+		"path":    fmt.Sprintf("%s/shop.json", client.pathPrefix),
+		"status":  float64(http.StatusOK),
+		"attempt": float64(1),
+	}
+	for key, value := range expected {
+		if record[key] != value {
+			t.Errorf("logged %s = %v, expected %v", key, record[key], value)
+		}
+	}
+	if _, ok := record["duration"]; !ok {
+		t.Errorf("logged record %v has no duration", record)
+	}
+}
+
+func TestWithRedactorLogsRedactedBodies(t *testing.T) {
+	setup()
+	defer teardown()
+
+	out := &bytes.Buffer{}
+	client.log = &LeveledLogger{Level: LevelDebug, stdoutOverride: out, stderrOverride: out}
+	client.redactor = NewRedactor()
+
+	httpmock.RegisterResponder("POST", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("customer.json")))
+
+	customer, err := client.Customer.Create(context.Background(), Customer{Email: "bob@example.com", Phone: "+15550001111"})
+	if err != nil {
+		t.Fatalf("Customer.Create returned error: %v", err)
+	}
+
+	logged := out.String()
+	for _, secret := range []string{"bob@example.com", "+15550001111", customer.Email} {
+		if secret != "" && strings.Contains(logged, secret) {
+			t.Errorf("logged output contains %q: %s", secret, logged)
+		}
+	}
+	if !strings.Contains(logged, "[REDACTED]") {
+		t.Errorf("logged output has no redacted values: %s", logged)
+	}
+}
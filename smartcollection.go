@@ -16,8 +16,10 @@ type SmartCollectionService interface {
 	List(context.Context, interface{}) ([]SmartCollection, error)
 	Count(context.Context, interface{}) (int, error)
 	Get(context.Context, int64, interface{}) (*SmartCollection, error)
+	GetMany(context.Context, []int64) (*GetManyResult[SmartCollection], error)
 	Create(context.Context, SmartCollection) (*SmartCollection, error)
 	Update(context.Context, SmartCollection) (*SmartCollection, error)
+	Patch(context.Context, int64, *Patch[SmartCollection]) (*SmartCollection, error)
 	Delete(context.Context, int64) error
 
 	// MetafieldsService used for SmartCollection resource to communicate with Metafields resource
@@ -87,6 +89,14 @@ func (s *SmartCollectionServiceOp) Get(ctx context.Context, collectionID int64,
 	return resource.Collection, err
 }
 
+// GetMany gets smart collections by id, in chunks of 250 ids
+func (s *SmartCollectionServiceOp) GetMany(ctx context.Context, ids []int64) (*GetManyResult[SmartCollection], error) {
+	path := fmt.Sprintf("%s.json", smartCollectionsBasePath)
+	return getMany(ctx, s.client, path, "smart_collections", ids, getManyChunkSize, "", func(resource SmartCollection) int64 {
+		return resource.ID
+	})
+}
+
 // Create a new smart collection
 // See Image for the details of the Image creation for a collection.
 func (s *SmartCollectionServiceOp) Create(ctx context.Context, collection SmartCollection) (*SmartCollection, error) {
@@ -106,6 +116,19 @@ func (s *SmartCollectionServiceOp) Update(ctx context.Context, collection SmartC
 	return resource.Collection, err
 }
 
+// Patch updates only the fields set in the patch of an existing smart collection
+func (s *SmartCollectionServiceOp) Patch(ctx context.Context, collectionID int64, patch *Patch[SmartCollection]) (*SmartCollection, error) {
+	body, err := patch.body(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("%s/%d.json", smartCollectionsBasePath, collectionID)
+	wrappedData := map[string]interface{}{"smart_collection": body}
+	resource := new(SmartCollectionResource)
+	err = s.client.Put(ctx, path, wrappedData, resource)
+	return resource.Collection, err
+}
+
 // Delete an existing smart collection.
 func (s *SmartCollectionServiceOp) Delete(ctx context.Context, collectionID int64) error {
 	return s.client.Delete(ctx, fmt.Sprintf("%s/%d.json", smartCollectionsBasePath, collectionID))
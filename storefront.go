@@ -0,0 +1,350 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+)
+
+const storefrontGraphQLPath = "graphql.json"
+
+type storefrontBuyerIPKey struct{}
+
+// StorefrontClient sends GraphQL queries to the Storefront API of a shop
+// using a storefront access token, see StorefrontAccessTokenService.
+// Requests go through the same logging, retry and error decoding as Client.
+// See: https://shopify.dev/docs/api/storefront
+type StorefrontClient struct {
+	client *Client
+	token  string
+
+	// URL Prefix, follows the version set with WithVersion, e.g.
+	// "api/2024-01", or the latest stable version when none is set
+	pathPrefix string
+}
+
+// GraphQLError is a single entry of the errors list of a GraphQL response
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// GraphQLErrors is returned when a GraphQL response contains errors
+type GraphQLErrors []GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Message)
+	}
+	return strings.Join(messages, ", ")
+}
+
+// NewStorefrontClient returns a new Storefront API client for the given shop
+// and storefront access token. The options are the same as for NewClient,
+// e.g. WithVersion, WithRetry, WithLogger and WithHTTPClient.
+// Without WithVersion the client is pinned to the latest stable version at
+// its creation, as the unversioned endpoint serves the oldest supported one.
+func NewStorefrontClient(shopName, token string, opts ...Option) *StorefrontClient {
+	c := NewClient(App{}, shopName, "", opts...)
+	if c.pathPrefix == defaultApiPathPrefix {
+		WithVersion(LatestStableAPIVersion(time.Now()).String())(c)
+	}
+
+	return &StorefrontClient{
+		client:     c,
+		token:      token,
+		pathPrefix: strings.TrimPrefix(c.pathPrefix, defaultApiPathPrefix+"/"),
+	}
+}
+
+// WithBuyerIP returns a context that forwards the given buyer IP address to
+// the Storefront API, which is required for server-side requests made with a
+// private storefront access token.
+func WithBuyerIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, storefrontBuyerIPKey{}, ip)
+}
+
+// Query sends a GraphQL query or mutation and decodes the "data" field of the
+// response into resource. Errors in the response are returned as GraphQLErrors.
+func (s *StorefrontClient) Query(ctx context.Context, query string, variables map[string]interface{}, resource interface{}) error {
+	data := struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables,omitempty"`
+	}{
+		Query:     query,
+		Variables: variables,
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", path.Join(s.pathPrefix, storefrontGraphQLPath), data, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Shopify-Storefront-Access-Token", s.token)
+	if ip, ok := ctx.Value(storefrontBuyerIPKey{}).(string); ok && ip != "" {
+		req.Header.Set("Shopify-Storefront-Buyer-IP", ip)
+	}
+
+	response := struct {
+		Data   interface{}   `json:"data"`
+		Errors GraphQLErrors `json:"errors"`
+	}{
+		Data: resource,
+	}
+
+	err = s.client.Do(req, &response)
+	if err != nil {
+		return err
+	}
+
+	if len(response.Errors) > 0 {
+		return response.Errors
+	}
+
+	return nil
+}
+
+// StorefrontMoney represents a monetary value in the Storefront API
+type StorefrontMoney struct {
+	Amount       string `json:"amount"`
+	CurrencyCode string `json:"currencyCode"`
+}
+
+// StorefrontProduct represents a product in the Storefront API
+type StorefrontProduct struct {
+	ID               string                     `json:"id"`
+	Title            string                     `json:"title"`
+	Handle           string                     `json:"handle"`
+	Description      string                     `json:"description"`
+	AvailableForSale bool                       `json:"availableForSale"`
+	Variants         StorefrontVariantsResource `json:"variants"`
+}
+
+// StorefrontVariant represents a product variant in the Storefront API
+type StorefrontVariant struct {
+	ID               string          `json:"id"`
+	Title            string          `json:"title"`
+	Sku              string          `json:"sku"`
+	AvailableForSale bool            `json:"availableForSale"`
+	Price            StorefrontMoney `json:"price"`
+}
+
+// StorefrontVariantsResource represents a product's variant connection
+type StorefrontVariantsResource struct {
+	Nodes []StorefrontVariant `json:"nodes"`
+}
+
+// StorefrontPageInfo represents the pagination info of a connection
+type StorefrontPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// StorefrontProductsResource represents a page of the products connection
+type StorefrontProductsResource struct {
+	Nodes    []StorefrontProduct `json:"nodes"`
+	PageInfo StorefrontPageInfo  `json:"pageInfo"`
+}
+
+// Cart represents a cart in the Storefront API
+type Cart struct {
+	ID            string            `json:"id"`
+	CheckoutURL   string            `json:"checkoutUrl"`
+	TotalQuantity int               `json:"totalQuantity"`
+	Lines         CartLinesResource `json:"lines"`
+	Cost          CartCost          `json:"cost"`
+}
+
+// CartCost represents the estimated costs of a cart
+type CartCost struct {
+	SubtotalAmount StorefrontMoney `json:"subtotalAmount"`
+	TotalAmount    StorefrontMoney `json:"totalAmount"`
+}
+
+// CartLine represents a line of a cart
+type CartLine struct {
+	ID          string            `json:"id"`
+	Quantity    int               `json:"quantity"`
+	Merchandise StorefrontVariant `json:"merchandise"`
+}
+
+// CartLinesResource represents a cart's line connection
+type CartLinesResource struct {
+	Nodes []CartLine `json:"nodes"`
+}
+
+// CartAttribute represents a custom key value pair on a cart or cart line
+type CartAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// CartLineInput is used to add a line to a cart
+type CartLineInput struct {
+	MerchandiseID string          `json:"merchandiseId"`
+	Quantity      int             `json:"quantity,omitempty"`
+	Attributes    []CartAttribute `json:"attributes,omitempty"`
+}
+
+// CartLineUpdateInput is used to update an existing line of a cart
+type CartLineUpdateInput struct {
+	ID            string          `json:"id"`
+	MerchandiseID string          `json:"merchandiseId,omitempty"`
+	Quantity      int             `json:"quantity,omitempty"`
+	Attributes    []CartAttribute `json:"attributes,omitempty"`
+}
+
+// CartInput is used to create a cart
+type CartInput struct {
+	Lines      []CartLineInput `json:"lines,omitempty"`
+	Attributes []CartAttribute `json:"attributes,omitempty"`
+	Note       string          `json:"note,omitempty"`
+}
+
+// CartUserError represents an error returned by a cart mutation
+type CartUserError struct {
+	Field   []string `json:"field"`
+	Message string   `json:"message"`
+	Code    string   `json:"code"`
+}
+
+// CartUserErrors is returned when a cart mutation returns user errors
+type CartUserErrors []CartUserError
+
+func (e CartUserErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		if len(err.Field) > 0 {
+			messages = append(messages, fmt.Sprintf("%s: %s", strings.Join(err.Field, "."), err.Message))
+		} else {
+			messages = append(messages, err.Message)
+		}
+	}
+	return strings.Join(messages, ", ")
+}
+
+const storefrontVariantFields = `id title sku availableForSale price { amount currencyCode }`
+
+const storefrontProductFields = `id title handle description availableForSale
+variants(first: 100) { nodes { ` + storefrontVariantFields + ` } }`
+
+const storefrontCartFields = `id checkoutUrl totalQuantity
+cost { subtotalAmount { amount currencyCode } totalAmount { amount currencyCode } }
+lines(first: 250) { nodes { id quantity merchandise { ... on ProductVariant { ` + storefrontVariantFields + ` } } } }`
+
+const storefrontProductQuery = `query product($handle: String!) {
+  product(handle: $handle) { ` + storefrontProductFields + ` }
+}`
+
+const storefrontProductsQuery = `query products($first: Int!, $after: String, $query: String) {
+  products(first: $first, after: $after, query: $query) {
+    nodes { ` + storefrontProductFields + ` }
+    pageInfo { hasNextPage endCursor }
+  }
+}`
+
+const storefrontCartCreateMutation = `mutation cartCreate($input: CartInput) {
+  cartCreate(input: $input) {
+    cart { ` + storefrontCartFields + ` }
+    userErrors { field message code }
+  }
+}`
+
+const storefrontCartLinesAddMutation = `mutation cartLinesAdd($cartId: ID!, $lines: [CartLineInput!]!) {
+  cartLinesAdd(cartId: $cartId, lines: $lines) {
+    cart { ` + storefrontCartFields + ` }
+    userErrors { field message code }
+  }
+}`
+
+const storefrontCartLinesUpdateMutation = `mutation cartLinesUpdate($cartId: ID!, $lines: [CartLineUpdateInput!]!) {
+  cartLinesUpdate(cartId: $cartId, lines: $lines) {
+    cart { ` + storefrontCartFields + ` }
+    userErrors { field message code }
+  }
+}`
+
+// cartPayload represents the result of a cart mutation
+type cartPayload struct {
+	Cart       *Cart          `json:"cart"`
+	UserErrors CartUserErrors `json:"userErrors"`
+}
+
+func (p cartPayload) result() (*Cart, error) {
+	if len(p.UserErrors) > 0 {
+		return p.Cart, p.UserErrors
+	}
+	return p.Cart, nil
+}
+
+// Product retrieves a product by its handle. It returns nil if the product
+// does not exist or is not published to the storefront.
+func (s *StorefrontClient) Product(ctx context.Context, handle string) (*StorefrontProduct, error) {
+	resource := struct {
+		Product *StorefrontProduct `json:"product"`
+	}{}
+	err := s.Query(ctx, storefrontProductQuery, map[string]interface{}{"handle": handle}, &resource)
+	return resource.Product, err
+}
+
+// Products retrieves a page of products. Pass the EndCursor of the previous
+// page as after to retrieve the next page, and an optional search query.
+func (s *StorefrontClient) Products(ctx context.Context, first int, after, query string) (*StorefrontProductsResource, error) {
+	variables := map[string]interface{}{"first": first}
+	if after != "" {
+		variables["after"] = after
+	}
+	if query != "" {
+		variables["query"] = query
+	}
+
+	resource := struct {
+		Products *StorefrontProductsResource `json:"products"`
+	}{}
+	err := s.Query(ctx, storefrontProductsQuery, variables, &resource)
+	return resource.Products, err
+}
+
+// CartCreate creates a new cart. User errors are returned as CartUserErrors.
+func (s *StorefrontClient) CartCreate(ctx context.Context, input CartInput) (*Cart, error) {
+	resource := struct {
+		CartCreate cartPayload `json:"cartCreate"`
+	}{}
+	err := s.Query(ctx, storefrontCartCreateMutation, map[string]interface{}{"input": input}, &resource)
+	if err != nil {
+		return nil, err
+	}
+	return resource.CartCreate.result()
+}
+
+// CartLinesAdd adds lines to an existing cart. User errors are returned as
+// CartUserErrors.
+func (s *StorefrontClient) CartLinesAdd(ctx context.Context, cartID string, lines []CartLineInput) (*Cart, error) {
+	resource := struct {
+		CartLinesAdd cartPayload `json:"cartLinesAdd"`
+	}{}
+	variables := map[string]interface{}{"cartId": cartID, "lines": lines}
+	err := s.Query(ctx, storefrontCartLinesAddMutation, variables, &resource)
+	if err != nil {
+		return nil, err
+	}
+	return resource.CartLinesAdd.result()
+}
+
+// CartLinesUpdate updates lines of an existing cart. User errors are returned
+// as CartUserErrors.
+func (s *StorefrontClient) CartLinesUpdate(ctx context.Context, cartID string, lines []CartLineUpdateInput) (*Cart, error) {
+	resource := struct {
+		CartLinesUpdate cartPayload `json:"cartLinesUpdate"`
+	}{}
+	variables := map[string]interface{}{"cartId": cartID, "lines": lines}
+	err := s.Query(ctx, storefrontCartLinesUpdateMutation, variables, &resource)
+	if err != nil {
+		return nil, err
+	}
+	return resource.CartLinesUpdate.result()
+}
@@ -0,0 +1,177 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+)
+
+const storefrontTestURL = "https://fooshop.myshopify.com/api/" + testApiVersion + "/graphql.json"
+
+func storefrontSetup() *StorefrontClient {
+	sf := NewStorefrontClient("fooshop", "storefront-token", WithVersion(testApiVersion), WithRetry(maxRetries))
+	httpmock.ActivateNonDefault(sf.client.Client)
+	return sf
+}
+
+// storefrontResponder checks the storefront headers and returns the fixture
+func storefrontResponder(t *testing.T, fixture string, check func(*http.Request, map[string]interface{})) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		if token := req.Header.Get("X-Shopify-Storefront-Access-Token"); token != "storefront-token" {
+			t.Errorf("X-Shopify-Storefront-Access-Token header returned %v, expected %v", token, "storefront-token")
+		}
+		if token := req.Header.Get("X-Shopify-Access-Token"); token != "" {
+			t.Errorf("X-Shopify-Access-Token header returned %v, expected none", token)
+		}
+
+		body := map[string]interface{}{}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			t.Errorf("request body is not JSON: %v", err)
+		}
+		if check != nil {
+			check(req, body)
+		}
+		return httpmock.NewBytesResponse(200, loadFixture(fixture)), nil
+	}
+}
+
+func TestNewStorefrontClientPathPrefix(t *testing.T) {
+	cases := []struct {
+		opts     []Option
+		expected string
+	}{
+		{nil, "api/" + LatestStableAPIVersion(time.Now()).String()},
+		{[]Option{WithVersion("invalid")}, "api/" + LatestStableAPIVersion(time.Now()).String()},
+		{[]Option{WithVersion("2024-01")}, "api/2024-01"},
+		{[]Option{WithVersion(UnstableApiVersion)}, "api/unstable"},
+	}
+
+	for _, c := range cases {
+		sf := NewStorefrontClient("fooshop", "storefront-token", c.opts...)
+		if sf.pathPrefix != c.expected {
+			t.Errorf("StorefrontClient.pathPrefix returned %v, expected %v", sf.pathPrefix, c.expected)
+		}
+	}
+}
+
+func TestStorefrontProduct(t *testing.T) {
+	sf := storefrontSetup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", storefrontTestURL,
+		storefrontResponder(t, "storefront/product.json", func(req *http.Request, body map[string]interface{}) {
+			variables, _ := body["variables"].(map[string]interface{})
+			if variables["handle"] != "ipod-nano" {
+				t.Errorf("StorefrontClient.Product handle variable returned %v, expected %v", variables["handle"], "ipod-nano")
+			}
+		}))
+
+	product, err := sf.Product(context.Background(), "ipod-nano")
+	if err != nil {
+		t.Fatalf("StorefrontClient.Product returned error: %v", err)
+	}
+
+	if product.Title != "IPod Nano - 8GB" {
+		t.Errorf("StorefrontProduct.Title returned %v, expected %v", product.Title, "IPod Nano - 8GB")
+	}
+	if len(product.Variants.Nodes) != 1 || product.Variants.Nodes[0].Price.Amount != "199.0" {
+		t.Errorf("StorefrontProduct.Variants returned %+v, expected one variant priced 199.0", product.Variants.Nodes)
+	}
+}
+
+func TestStorefrontProducts(t *testing.T) {
+	sf := storefrontSetup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", storefrontTestURL,
+		storefrontResponder(t, "storefront/products.json", func(req *http.Request, body map[string]interface{}) {
+			variables, _ := body["variables"].(map[string]interface{})
+			if variables["first"] != float64(10) || variables["after"] != "abc" {
+				t.Errorf("StorefrontClient.Products variables returned %v, expected first=10 after=abc", variables)
+			}
+			if _, ok := variables["query"]; ok {
+				t.Errorf("StorefrontClient.Products sent an empty query variable")
+			}
+		}))
+
+	products, err := sf.Products(context.Background(), 10, "abc", "")
+	if err != nil {
+		t.Fatalf("StorefrontClient.Products returned error: %v", err)
+	}
+
+	if len(products.Nodes) != 1 {
+		t.Errorf("StorefrontClient.Products got %v products, expected 1", len(products.Nodes))
+	}
+	if !products.PageInfo.HasNextPage || products.PageInfo.EndCursor != "eyJsYXN0X2lkIjo2MzI5MTAzOTJ9" {
+		t.Errorf("StorefrontProductsResource.PageInfo returned %+v", products.PageInfo)
+	}
+}
+
+func TestStorefrontCartCreate(t *testing.T) {
+	sf := storefrontSetup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", storefrontTestURL,
+		storefrontResponder(t, "storefront/cart_create.json", func(req *http.Request, body map[string]interface{}) {
+			if ip := req.Header.Get("Shopify-Storefront-Buyer-IP"); ip != "192.0.2.1" {
+				t.Errorf("Shopify-Storefront-Buyer-IP header returned %v, expected %v", ip, "192.0.2.1")
+			}
+		}))
+
+	ctx := WithBuyerIP(context.Background(), "192.0.2.1")
+	cart, err := sf.CartCreate(ctx, CartInput{
+		Lines: []CartLineInput{{MerchandiseID: "gid://shopify/ProductVariant/808950810", Quantity: 2}},
+	})
+	if err != nil {
+		t.Fatalf("StorefrontClient.CartCreate returned error: %v", err)
+	}
+
+	if cart.TotalQuantity != 2 {
+		t.Errorf("Cart.TotalQuantity returned %v, expected %v", cart.TotalQuantity, 2)
+	}
+	if len(cart.Lines.Nodes) != 1 || cart.Lines.Nodes[0].Merchandise.Sku != "IPOD2008PINK" {
+		t.Errorf("Cart.Lines returned %+v, expected one IPOD2008PINK line", cart.Lines.Nodes)
+	}
+}
+
+func TestStorefrontCartLinesUpdateUserErrors(t *testing.T) {
+	sf := storefrontSetup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", storefrontTestURL,
+		httpmock.NewStringResponder(200, `{"data":{"cartLinesUpdate":{"cart":null,"userErrors":[{"field":["lines","0","quantity"],"message":"must be positive","code":"INVALID"}]}}}`))
+
+	_, err := sf.CartLinesUpdate(context.Background(), "gid://shopify/Cart/1", []CartLineUpdateInput{{ID: "gid://shopify/CartLine/1", Quantity: -1}})
+
+	var userErrors CartUserErrors
+	if !errors.As(err, &userErrors) {
+		t.Fatalf("StorefrontClient.CartLinesUpdate returned %v, expected CartUserErrors", err)
+	}
+	expected := "lines.0.quantity: must be positive"
+	if err.Error() != expected {
+		t.Errorf("CartUserErrors.Error returned %v, expected %v", err.Error(), expected)
+	}
+}
+
+func TestStorefrontQueryErrors(t *testing.T) {
+	sf := storefrontSetup()
+	defer teardown()
+
+	httpmock.RegisterResponder("POST", storefrontTestURL,
+		httpmock.NewStringResponder(200, `{"errors":[{"message":"Field 'foo' doesn't exist on type 'QueryRoot'","extensions":{"code":"undefinedField"}}]}`))
+
+	err := sf.Query(context.Background(), "{ foo }", nil, nil)
+
+	var gqlErrors GraphQLErrors
+	if !errors.As(err, &gqlErrors) {
+		t.Fatalf("StorefrontClient.Query returned %v, expected GraphQLErrors", err)
+	}
+	if gqlErrors[0].Extensions["code"] != "undefinedField" {
+		t.Errorf("GraphQLError.Extensions returned %v, expected code undefinedField", gqlErrors[0].Extensions)
+	}
+}
@@ -0,0 +1,104 @@
+package goshopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// responseStreamer is implemented by resources which decode the response
+// body themselves instead of having it decoded in one go
+type responseStreamer interface {
+	streamResponse(r io.Reader) error
+}
+
+// listStreamer decodes the array under key of a list response one element at
+// a time and hands each element to fn, so only one element is held in memory
+type listStreamer[T any] struct {
+	key string
+	fn  func(T) error
+
+	// err is the error returned by fn, it stops the decoding
+	err error
+}
+
+func (s *listStreamer[T]) streamResponse(r io.Reader) error {
+	decoder := json.NewDecoder(r)
+
+	if err := expectDelim(decoder, '{'); err != nil {
+		return err
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		if key, _ := token.(string); key != s.key {
+			// skip any other field of the envelope
+			var skip json.RawMessage
+			if err := decoder.Decode(&skip); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := expectDelim(decoder, '['); err != nil {
+			return err
+		}
+		for decoder.More() {
+			var elem T
+			if err := decoder.Decode(&elem); err != nil {
+				return err
+			}
+			if err := s.fn(elem); err != nil {
+				s.err = err
+				return err
+			}
+		}
+		if err := expectDelim(decoder, ']'); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(decoder, '}')
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("expected %v, got %v", delim, token)
+	}
+	return nil
+}
+
+// streamList requests every page of a list endpoint and calls fn with each
+// element of the array under key. It stops at the first error returned by fn
+// and returns that error. fn is called while the body is read, within the
+// Timeout of the http client and RequestTimeout.
+func streamList[T any](ctx context.Context, c *Client, path, key string, options interface{}, fn func(T) error) error {
+	for {
+		streamer := &listStreamer[T]{key: key, fn: fn}
+		headers, err := c.createAndDoGetHeaders(ctx, "GET", path, nil, options, streamer)
+		if streamer.err != nil {
+			return streamer.err
+		}
+		if err != nil {
+			return err
+		}
+
+		pagination, err := extractPagination(headers.Get("Link"))
+		if err != nil {
+			return err
+		}
+		if pagination.NextPageOptions == nil {
+			return nil
+		}
+		options = pagination.NextPageOptions
+	}
+}
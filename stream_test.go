@@ -0,0 +1,154 @@
+package goshopify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestListStreamer(t *testing.T) {
+	cases := []struct {
+		body     string
+		expected []int64
+		err      bool
+	}{
+		{`{"products":[{"id":1},{"id":2},{"id":3}]}`, []int64{1, 2, 3}, false},
+		{`{"other":{"nested":[1,2]},"products":[{"id":1}],"count":1}`, []int64{1}, false},
+		{`{"products":[]}`, nil, false},
+		{`{}`, nil, false},
+		{`{"products":[{"id":1},{"id":"x"}]}`, []int64{1}, true},
+		{`[]`, nil, true},
+		{`{"products":[{"id":1}`, []int64{1}, true},
+	}
+
+	for _, c := range cases {
+		var ids []int64
+		streamer := &listStreamer[Product]{key: "products", fn: func(p Product) error {
+			ids = append(ids, p.ID)
+			return nil
+		}}
+
+		err := streamer.streamResponse(strings.NewReader(c.body))
+		if (err != nil) != c.err {
+			t.Errorf("streamResponse(%s) returned error %v, expected error %v", c.body, err, c.err)
+		}
+		if !reflect.DeepEqual(ids, c.expected) {
+			t.Errorf("streamResponse(%s) streamed %v, expected %v", c.body, ids, c.expected)
+		}
+	}
+}
+
+func TestProductStreamProducts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/products.json", client.pathPrefix)
+	httpmock.RegisterResponderWithQuery("GET", listURL, "limit=2",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{"products":[{"id":1},{"id":2}]}`)
+			resp.Header.Set("Link", fmt.Sprintf(`<%s?page_info=abc&limit=2>; rel="next"`, listURL))
+			return resp, nil
+		})
+	httpmock.RegisterResponderWithQuery("GET", listURL, "page_info=abc&limit=2",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{"products":[{"id":3}]}`)
+			resp.Header.Set("Link", fmt.Sprintf(`<%s?page_info=xyz&limit=2>; rel="previous"`, listURL))
+			return resp, nil
+		})
+
+	var ids []int64
+	err := client.Product.StreamProducts(context.Background(), ListOptions{Limit: 2}, func(p Product) error {
+		ids = append(ids, p.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Product.StreamProducts returned error: %v", err)
+	}
+
+	expected := []int64{1, 2, 3}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Product.StreamProducts streamed %v, expected %v", ids, expected)
+	}
+}
+
+func TestProductStreamProductsCallbackError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	listURL := fmt.Sprintf("https://fooshop.myshopify.com/%s/products.json", client.pathPrefix)
+	httpmock.RegisterResponder("GET", listURL,
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(200, `{"products":[{"id":1},{"id":2}]}`)
+			resp.Header.Set("Link", fmt.Sprintf(`<%s?page_info=abc>; rel="next"`, listURL))
+			return resp, nil
+		})
+
+	stop := errors.New("stop")
+	var ids []int64
+	err := client.Product.StreamProducts(context.Background(), nil, func(p Product) error {
+		ids = append(ids, p.ID)
+		return stop
+	})
+	if err != stop {
+		t.Errorf("Product.StreamProducts returned %v, expected %v", err, stop)
+	}
+	if !reflect.DeepEqual(ids, []int64{1}) {
+		t.Errorf("Product.StreamProducts streamed %v after the callback error, expected [1]", ids)
+	}
+	if calls := httpmock.GetTotalCallCount(); calls != 1 {
+		t.Errorf("Product.StreamProducts made %d requests, expected 1", calls)
+	}
+}
+
+func TestOrderStreamOrders(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/orders.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("orders.json")))
+
+	count := 0
+	err := client.Order.StreamOrders(context.Background(), nil, func(o Order) error {
+		if o.ID == 0 {
+			t.Errorf("Order.StreamOrders streamed an order without ID")
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Order.StreamOrders returned error: %v", err)
+	}
+
+	orders, _ := client.Order.List(context.Background(), nil)
+	if count != len(orders) {
+		t.Errorf("Order.StreamOrders streamed %d orders, expected %d", count, len(orders))
+	}
+}
+
+func TestCustomerStreamCustomers(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/customers.json", client.pathPrefix),
+		httpmock.NewStringResponder(200, `{"customers": [{"id":1},{"id":2}]}`))
+
+	var ids []int64
+	err := client.Customer.StreamCustomers(context.Background(), nil, func(c Customer) error {
+		ids = append(ids, c.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Customer.StreamCustomers returned error: %v", err)
+	}
+
+	expected := []int64{1, 2}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Customer.StreamCustomers streamed %v, expected %v", ids, expected)
+	}
+}
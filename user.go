@@ -0,0 +1,78 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+)
+
+const usersBasePath = "users"
+
+// UserService is an interface for interfacing with the user endpoints
+// of the Shopify API. These endpoints are only available on Shopify Plus
+// stores.
+// See: https://shopify.dev/docs/api/admin-rest/latest/resources/user
+type UserService interface {
+	List(context.Context, interface{}) ([]User, error)
+	Get(context.Context, int64, interface{}) (*User, error)
+	Current(context.Context) (*User, error)
+}
+
+// UserServiceOp handles communication with the user related methods of
+// the Shopify API.
+type UserServiceOp struct {
+	client *Client
+}
+
+// User represents a staff account on a Shopify shop
+type User struct {
+	ID                   int64    `json:"id,omitempty"`
+	FirstName            string   `json:"first_name,omitempty"`
+	LastName             string   `json:"last_name,omitempty"`
+	Email                string   `json:"email,omitempty"`
+	Url                  string   `json:"url,omitempty"`
+	Im                   string   `json:"im,omitempty"`
+	ScreenName           string   `json:"screen_name,omitempty"`
+	Phone                string   `json:"phone,omitempty"`
+	AccountOwner         bool     `json:"account_owner,omitempty"`
+	ReceiveAnnouncements int      `json:"receive_announcements,omitempty"`
+	Bio                  string   `json:"bio,omitempty"`
+	Permissions          []string `json:"permissions,omitempty"`
+	Locale               string   `json:"locale,omitempty"`
+	UserType             string   `json:"user_type,omitempty"`
+	TfaEnabled           bool     `json:"tfa_enabled?,omitempty"`
+	AdminGraphqlAPIID    string   `json:"admin_graphql_api_id,omitempty"`
+}
+
+// UserResource represents the result from the users/X.json endpoint
+type UserResource struct {
+	User *User `json:"user"`
+}
+
+// UsersResource represents the result from the users.json endpoint
+type UsersResource struct {
+	Users []User `json:"users"`
+}
+
+// List users
+func (s *UserServiceOp) List(ctx context.Context, options interface{}) ([]User, error) {
+	path := fmt.Sprintf("%s.json", usersBasePath)
+	resource := new(UsersResource)
+	err := s.client.Get(ctx, path, resource, options)
+	return resource.Users, err
+}
+
+// Get a single user
+func (s *UserServiceOp) Get(ctx context.Context, userID int64, options interface{}) (*User, error) {
+	path := fmt.Sprintf("%s/%d.json", usersBasePath, userID)
+	resource := new(UserResource)
+	err := s.client.Get(ctx, path, resource, options)
+	return resource.User, err
+}
+
+// Current retrieves the user the current access token was issued for
+func (s *UserServiceOp) Current(ctx context.Context) (*User, error) {
+	path := fmt.Sprintf("%s/current.json", usersBasePath)
+	resource := new(UserResource)
+	err := s.client.Get(ctx, path, resource, nil)
+	return resource.User, err
+}
@@ -0,0 +1,80 @@
+package goshopify
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func userTests(t *testing.T, user User) {
+	expectedInt := int64(548380009)
+	if user.ID != expectedInt {
+		t.Errorf("User.ID returned %+v, expected %+v", user.ID, expectedInt)
+	}
+
+	expectedStr := "j.smith@example.com"
+	if user.Email != expectedStr {
+		t.Errorf("User.Email returned %+v, expected %+v", user.Email, expectedStr)
+	}
+
+	if !user.AccountOwner {
+		t.Errorf("User.AccountOwner returned %+v, expected %+v", user.AccountOwner, true)
+	}
+
+	expectedPermissions := []string{"applications", "full"}
+	if !reflect.DeepEqual(user.Permissions, expectedPermissions) {
+		t.Errorf("User.Permissions returned %+v, expected %+v", user.Permissions, expectedPermissions)
+	}
+}
+
+func TestUserList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/users.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("users.json")))
+
+	users, err := client.User.List(context.Background(), nil)
+	if err != nil {
+		t.Errorf("User.List returned error: %v", err)
+	}
+
+	if len(users) != 1 {
+		t.Fatalf("User.List got %v users, expected: 1", len(users))
+	}
+
+	userTests(t, users[0])
+}
+
+func TestUserGet(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/users/548380009.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("user.json")))
+
+	user, err := client.User.Get(context.Background(), 548380009, nil)
+	if err != nil {
+		t.Errorf("User.Get returned error: %v", err)
+	}
+
+	userTests(t, *user)
+}
+
+func TestUserCurrent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	httpmock.RegisterResponder("GET", fmt.Sprintf("https://fooshop.myshopify.com/%s/users/current.json", client.pathPrefix),
+		httpmock.NewBytesResponder(200, loadFixture("user.json")))
+
+	user, err := client.User.Current(context.Background())
+	if err != nil {
+		t.Errorf("User.Current returned error: %v", err)
+	}
+
+	userTests(t, *user)
+}
@@ -2,9 +2,14 @@ package goshopify
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 )
 
+// shopDomainRegex matches a myshopify domain, shop handles are made of
+// letters, digits and hyphens and can't start with a hyphen
+var shopDomainRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,62}\.myshopify\.com$`)
+
 // Return the full shop name, including .myshopify.com
 func ShopFullName(name string) string {
 	name = strings.TrimSpace(name)
@@ -29,6 +34,21 @@ func ShopBaseUrl(name string) string {
 	return fmt.Sprintf("https://%s", name)
 }
 
+// ValidShopDomain returns the myshopify domain of a shop name, e.g.
+// "theshop" or "theshop.myshopify.com", or an error if it is not a valid
+// myshopify domain. Unlike ShopFullName it rejects names such as
+// "theshop.myshopify.com.evil.com" or "evil.com/theshop.myshopify.com".
+func ValidShopDomain(name string) (string, error) {
+	domain := strings.ToLower(strings.TrimSpace(name))
+	if !strings.Contains(domain, ".") {
+		domain += ".myshopify.com"
+	}
+	if !shopDomainRegex.MatchString(domain) {
+		return "", fmt.Errorf("invalid shop domain %q, expected <shop>.myshopify.com", name)
+	}
+	return domain, nil
+}
+
 // Return the prefix for a metafield path
 func MetafieldPathPrefix(resource string, resourceID int64) string {
 	prefix := "metafields"
@@ -100,3 +100,29 @@ func TestFulfillmentPathPrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidShopDomain(t *testing.T) {
+	cases := []struct {
+		in, expected string
+		valid        bool
+	}{
+		{"myshop", "myshop.myshopify.com", true},
+		{" MyShop.myshopify.com\n", "myshop.myshopify.com", true},
+		{"my-shop-2", "my-shop-2.myshopify.com", true},
+		{"-myshop", "", false},
+		{"my_shop", "", false},
+		{"myshop.", "", false},
+		{"myshop.com", "", false},
+		{"myshop.myshopify.com.evil.com", "", false},
+		{"evil.com/myshop.myshopify.com", "", false},
+		{"https://myshop.myshopify.com", "", false},
+		{"", "", false},
+	}
+
+	for _, c := range cases {
+		actual, err := ValidShopDomain(c.in)
+		if actual != c.expected || (err == nil) != c.valid {
+			t.Errorf("ValidShopDomain(%q): expected %q, valid %v, actual %q, %v", c.in, c.expected, c.valid, actual, err)
+		}
+	}
+}
@@ -18,8 +18,10 @@ type VariantService interface {
 	List(context.Context, int64, interface{}) ([]Variant, error)
 	Count(context.Context, int64, interface{}) (int, error)
 	Get(context.Context, int64, interface{}) (*Variant, error)
+	GetMany(context.Context, []int64) (*GetManyResult[Variant], error)
 	Create(context.Context, int64, Variant) (*Variant, error)
 	Update(context.Context, Variant) (*Variant, error)
+	Patch(context.Context, int64, *Patch[Variant]) (*Variant, error)
 	Delete(context.Context, int64, int64) error
 
 	// MetafieldsService used for Variant resource to communicate with Metafields resource
@@ -96,6 +98,13 @@ func (s *VariantServiceOp) Get(ctx context.Context, variantID int64, options int
 	return resource.Variant, err
 }
 
+// GetMany gets variants by id, one at a time as they can't be listed by ids
+func (s *VariantServiceOp) GetMany(ctx context.Context, ids []int64) (*GetManyResult[Variant], error) {
+	return getEach(ctx, ids, func(ctx context.Context, id int64) (*Variant, error) {
+		return s.Get(ctx, id, nil)
+	})
+}
+
 // Create a new variant
 func (s *VariantServiceOp) Create(ctx context.Context, productID int64, variant Variant) (*Variant, error) {
 	path := fmt.Sprintf("%s/%d/variants.json", productsBasePath, productID)
@@ -114,6 +123,19 @@ func (s *VariantServiceOp) Update(ctx context.Context, variant Variant) (*Varian
 	return resource.Variant, err
 }
 
+// Patch updates only the fields set in the patch of an existing variant
+func (s *VariantServiceOp) Patch(ctx context.Context, variantID int64, patch *Patch[Variant]) (*Variant, error) {
+	body, err := patch.body(variantID)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("%s/%d.json", variantsBasePath, variantID)
+	wrappedData := map[string]interface{}{"variant": body}
+	resource := new(VariantResource)
+	err = s.client.Put(ctx, path, wrappedData, resource)
+	return resource.Variant, err
+}
+
 // Delete an existing variant
 func (s *VariantServiceOp) Delete(ctx context.Context, productID int64, variantID int64) error {
 	return s.client.Delete(ctx, fmt.Sprintf("%s/%d/variants/%d.json", productsBasePath, productID, variantID))
@@ -0,0 +1,98 @@
+package goshopify
+
+import (
+	"fmt"
+	"time"
+)
+
+// apiVersionSupportMonths is how long a stable api version is supported
+// after its release
+const apiVersionSupportMonths = 12
+
+// APIVersion is a stable Shopify API version. Shopify releases a version
+// every quarter, in January, April, July and October, and supports it for
+// 12 months. See https://shopify.dev/docs/api/usage/versioning
+type APIVersion struct {
+	Year  int
+	Month time.Month
+}
+
+// ParseAPIVersion parses the name of a stable version, e.g. "2024-01"
+func ParseAPIVersion(name string) (APIVersion, error) {
+	if !apiVersionRegex.MatchString(name) {
+		return APIVersion{}, fmt.Errorf("invalid api version %q", name)
+	}
+	t, err := time.Parse("2006-01", name)
+	if err != nil {
+		return APIVersion{}, fmt.Errorf("invalid api version %q: %w", name, err)
+	}
+	if (t.Month()-1)%3 != 0 {
+		return APIVersion{}, fmt.Errorf("invalid api version %q, versions are released in January, April, July and October", name)
+	}
+	return APIVersion{Year: t.Year(), Month: t.Month()}, nil
+}
+
+// LatestStableAPIVersion returns the latest version released at a time
+func LatestStableAPIVersion(t time.Time) APIVersion {
+	t = t.UTC()
+	return APIVersion{Year: t.Year(), Month: t.Month() - (t.Month()-1)%3}
+}
+
+// OldestSupportedAPIVersion returns the oldest version still supported at a
+// time, older versions are sunset and Shopify serves requests for them with
+// the oldest supported one
+func OldestSupportedAPIVersion(t time.Time) APIVersion {
+	return LatestStableAPIVersion(t).addQuarters(1 - apiVersionSupportMonths/3)
+}
+
+// SupportedAPIVersions returns the versions supported at a time from the
+// oldest to the latest
+func SupportedAPIVersions(t time.Time) []APIVersion {
+	latest := LatestStableAPIVersion(t)
+	var versions []APIVersion
+	for v := OldestSupportedAPIVersion(t); !latest.Before(v); v = v.Next() {
+		versions = append(versions, v)
+	}
+	return versions
+}
+
+// String returns the name of the version, e.g. "2024-01"
+func (v APIVersion) String() string {
+	return fmt.Sprintf("%04d-%02d", v.Year, int(v.Month))
+}
+
+// ReleasedAt returns the release date of the version
+func (v APIVersion) ReleasedAt() time.Time {
+	return time.Date(v.Year, v.Month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// SunsetAt returns the date the version stops being supported
+func (v APIVersion) SunsetAt() time.Time {
+	return v.ReleasedAt().AddDate(0, apiVersionSupportMonths, 0)
+}
+
+// SupportedAt reports whether the version is released and not sunset at a
+// time
+func (v APIVersion) SupportedAt(t time.Time) bool {
+	return !t.Before(v.ReleasedAt()) && t.Before(v.SunsetAt())
+}
+
+// Next returns the version released after v
+func (v APIVersion) Next() APIVersion {
+	return v.addQuarters(1)
+}
+
+// Previous returns the version released before v
+func (v APIVersion) Previous() APIVersion {
+	return v.addQuarters(-1)
+}
+
+// Before reports whether v was released before o
+func (v APIVersion) Before(o APIVersion) bool {
+	return v.ReleasedAt().Before(o.ReleasedAt())
+}
+
+func (v APIVersion) addQuarters(n int) APIVersion {
+	t := v.ReleasedAt().AddDate(0, 3*n, 0)
+	return APIVersion{Year: t.Year(), Month: t.Month()}
+}
@@ -0,0 +1,102 @@
+package goshopify
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseAPIVersion(t *testing.T) {
+	cases := []struct {
+		name     string
+		expected APIVersion
+		err      bool
+	}{
+		{"2024-01", APIVersion{2024, time.January}, false},
+		{"2023-10", APIVersion{2023, time.October}, false},
+		{"2024-02", APIVersion{}, true},
+		{"2024-13", APIVersion{}, true},
+		{"unstable", APIVersion{}, true},
+		{"24-01", APIVersion{}, true},
+	}
+
+	for _, c := range cases {
+		v, err := ParseAPIVersion(c.name)
+		if (err != nil) != c.err {
+			t.Errorf("ParseAPIVersion(%q) returned error %v, expected error %v", c.name, err, c.err)
+		}
+		if v != c.expected {
+			t.Errorf("ParseAPIVersion(%q) = %+v, expected %+v", c.name, v, c.expected)
+		}
+		if !c.err && v.String() != c.name {
+			t.Errorf("APIVersion.String() = %s, expected %s", v.String(), c.name)
+		}
+	}
+}
+
+func TestAPIVersionLifecycle(t *testing.T) {
+	cases := []struct {
+		date     time.Time
+		latest   string
+		oldest   string
+		versions []string
+	}{
+		{
+			time.Date(2024, time.May, 15, 12, 0, 0, 0, time.UTC),
+			"2024-04", "2023-07",
+			[]string{"2023-07", "2023-10", "2024-01", "2024-04"},
+		},
+		{
+			time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			"2024-01", "2023-04",
+			[]string{"2023-04", "2023-07", "2023-10", "2024-01"},
+		},
+		{
+			time.Date(2023, time.December, 31, 23, 59, 0, 0, time.UTC),
+			"2023-10", "2023-01",
+			[]string{"2023-01", "2023-04", "2023-07", "2023-10"},
+		},
+	}
+
+	for _, c := range cases {
+		if latest := LatestStableAPIVersion(c.date).String(); latest != c.latest {
+			t.Errorf("LatestStableAPIVersion(%s) = %s, expected %s", c.date, latest, c.latest)
+		}
+		if oldest := OldestSupportedAPIVersion(c.date).String(); oldest != c.oldest {
+			t.Errorf("OldestSupportedAPIVersion(%s) = %s, expected %s", c.date, oldest, c.oldest)
+		}
+
+		var versions []string
+		for _, v := range SupportedAPIVersions(c.date) {
+			versions = append(versions, v.String())
+			if !v.SupportedAt(c.date) {
+				t.Errorf("APIVersion(%s).SupportedAt(%s) = false, expected true", v, c.date)
+			}
+		}
+		if !reflect.DeepEqual(versions, c.versions) {
+			t.Errorf("SupportedAPIVersions(%s) = %v, expected %v", c.date, versions, c.versions)
+		}
+		if OldestSupportedAPIVersion(c.date).Previous().SupportedAt(c.date) {
+			t.Errorf("version before the oldest supported one is supported at %s", c.date)
+		}
+	}
+}
+
+func TestAPIVersionDates(t *testing.T) {
+	v := APIVersion{2023, time.October}
+
+	expectedRelease := time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC)
+	if !v.ReleasedAt().Equal(expectedRelease) {
+		t.Errorf("APIVersion.ReleasedAt() = %s, expected %s", v.ReleasedAt(), expectedRelease)
+	}
+	expectedSunset := time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)
+	if !v.SunsetAt().Equal(expectedSunset) {
+		t.Errorf("APIVersion.SunsetAt() = %s, expected %s", v.SunsetAt(), expectedSunset)
+	}
+	if next := v.Next(); next != (APIVersion{2024, time.January}) {
+		t.Errorf("APIVersion.Next() = %s, expected 2024-01", next)
+	}
+	if !v.Before(v.Next()) || v.Next().Before(v) {
+		t.Errorf("APIVersion.Before is not ordered by release")
+	}
+}